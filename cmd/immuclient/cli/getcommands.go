@@ -27,3 +27,7 @@ func (cli *cli) getKey(args []string) (string, error) {
 func (cli *cli) safeGetKey(args []string) (string, error) {
 	return cli.immucl.VerifiedGet(args)
 }
+
+func (cli *cli) safeGetAllKeys(args []string) (string, error) {
+	return cli.immucl.VerifiedGetAll(args)
+}
@@ -32,6 +32,7 @@ func (cli *cli) initCommands() {
 
 	// Get commands
 	cli.Register(&command{"safeget", "Get and verify item having the specified key", cli.safeGetKey, []string{"key"}, false})
+	cli.Register(&command{"safegetall", "Get and verify items having the specified keys against a single state", cli.safeGetAllKeys, []string{"key"}, true})
 	cli.Register(&command{"get", "Get item having the specified key", cli.getKey, []string{"key"}, false})
 	cli.Register(&command{"gettx", "Return a tx by id", cli.getTxByID, []string{"id"}, false})
 
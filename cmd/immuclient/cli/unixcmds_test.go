@@ -29,7 +29,7 @@ func TestCompleter(t *testing.T) {
 	cli.initCommands()
 	cm := cli.completer("safe")
 
-	assert.EqualValues(t, 4, len(cm))
+	assert.EqualValues(t, 5, len(cm))
 }
 
 func TestClear(t *testing.T) {
@@ -52,8 +52,10 @@ func (cl *commandline) Register(rootCmd *cobra.Command) *cobra.Command {
 	// get operations
 	cl.getTxByID(rootCmd)
 	cl.safegetTxByID(rootCmd)
+	cl.tail(rootCmd)
 	cl.getKey(rootCmd)
 	cl.safeGetKey(rootCmd)
+	cl.safeGetAllKeys(rootCmd)
 	// set operations
 	cl.set(rootCmd)
 	cl.safeset(rootCmd)
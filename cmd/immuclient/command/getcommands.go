@@ -17,6 +17,13 @@ limitations under the License.
 package immuclient
 
 import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
@@ -60,6 +67,38 @@ func (cl *commandline) safegetTxByID(cmd *cobra.Command) {
 	cmd.AddCommand(ccmd)
 }
 
+func (cl *commandline) tail(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "tail",
+		Short:             "Print new transactions as they are committed, until interrupted",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromTx, err := cmd.Flags().GetUint64("from-tx")
+			if err != nil {
+				cl.quit(err)
+			}
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				cl.quit(err)
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			err = cl.immucl.Tail(ctx, fromTx, interval, cmd.OutOrStdout())
+			if err != nil {
+				cl.quit(err)
+			}
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+	ccmd.Flags().Uint64("from-tx", 0, "print transactions starting from the specified one, by default only transactions committed from now on are printed")
+	ccmd.Flags().Duration("interval", time.Second, "how often the server is checked for new transactions")
+	cmd.AddCommand(ccmd)
+}
+
 func (cl *commandline) getKey(cmd *cobra.Command) {
 	ccmd := &cobra.Command{
 		Use:               "get key[@revision]",
@@ -68,7 +107,19 @@ func (cl *commandline) getKey(cmd *cobra.Command) {
 		PersistentPreRunE: cl.ConfigChain(cl.connect),
 		PersistentPostRun: cl.disconnect,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			resp, err := cl.immucl.Get(args)
+			atTx, err := cmd.Flags().GetUint64("at-tx")
+			if err != nil {
+				cl.quit(err)
+			}
+			atRevision, err := cmd.Flags().GetInt64("at-revision")
+			if err != nil {
+				cl.quit(err)
+			}
+			if cmd.Flags().Changed("at-tx") && cmd.Flags().Changed("at-revision") {
+				cl.quit(errors.New("at-tx and at-revision flags can not be used together"))
+			}
+
+			resp, err := cl.immucl.GetAt(args, atTx, atRevision)
 			if err != nil {
 				cl.quit(err)
 			}
@@ -77,6 +128,8 @@ func (cl *commandline) getKey(cmd *cobra.Command) {
 		},
 		Args: cobra.ExactArgs(1),
 	}
+	ccmd.Flags().Uint64("at-tx", 0, "get the value the key had at the specified transaction")
+	ccmd.Flags().Int64("at-revision", 0, "get the value the key had at the specified revision, negative values are relative to the latest one")
 	cmd.AddCommand(ccmd)
 }
 
@@ -99,3 +152,23 @@ func (cl *commandline) safeGetKey(cmd *cobra.Command) {
 	}
 	cmd.AddCommand(ccmd)
 }
+
+func (cl *commandline) safeGetAllKeys(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "safegetall key [key...]",
+		Short:             "Get and verify items having the specified keys against a single state",
+		Aliases:           []string{"sga"},
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := cl.immucl.VerifiedGetAll(args)
+			if err != nil {
+				cl.quit(err)
+			}
+			fprintln(cmd.OutOrStdout(), resp)
+			return nil
+		},
+		Args: cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(ccmd)
+}
@@ -20,15 +20,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/client"
 )
 
 var (
-	errZeroTxID = errors.New("tx id cannot be 0 (should be bigger than 0)")
+	errZeroTxID             = errors.New("tx id cannot be 0 (should be bigger than 0)")
+	errAtTxAndRevision      = errors.New("only one of tx id and revision can be specified")
+	errRevisionAlreadyInKey = errors.New("revision already specified in the key")
 )
 
 func (i *immuc) GetTxByID(args []string) (string, error) {
@@ -57,6 +61,56 @@ func (i *immuc) GetTxByID(args []string) (string, error) {
 	return PrintTx(tx.(*schema.Tx), false), nil
 }
 
+// Tail writes the transactions committed from fromTx onwards as they arrive, until the context is done.
+// When fromTx is zero only the transactions committed after the current state are written.
+// The server is checked for new transactions every interval.
+func (i *immuc) Tail(ctx context.Context, fromTx uint64, interval time.Duration, out io.Writer) error {
+	if interval <= 0 {
+		return errors.New("interval must be greater than 0")
+	}
+
+	nextTx := fromTx
+
+	if nextTx == 0 {
+		state, err := i.Execute(func(immuClient client.ImmuClient) (interface{}, error) {
+			return immuClient.CurrentState(ctx)
+		})
+		if err != nil {
+			return err
+		}
+
+		nextTx = state.(*schema.ImmutableState).TxId + 1
+	}
+
+	for {
+		txs, err := i.Execute(func(immuClient client.ImmuClient) (interface{}, error) {
+			return immuClient.TxScan(ctx, &schema.TxScanRequest{InitialTx: nextTx})
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range txs.(*schema.TxList).Txs {
+			fmt.Fprint(out, PrintTxSummary(tx))
+			nextTx = tx.Header.Id + 1
+		}
+
+		if len(txs.(*schema.TxList).Txs) > 0 {
+			// there may be more transactions than the ones returned at once
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
 func (i *immuc) VerifiedGetTxByID(args []string) (string, error) {
 	id, err := strconv.ParseUint(args[0], 10, 64)
 	if err != nil {
@@ -107,14 +161,38 @@ func (i *immuc) parseKeyArg(arg string) (key []byte, revision int64, hasRevision
 }
 
 func (i *immuc) Get(args []string) (string, error) {
-	key, atRevision, _, err := i.parseKeyArg(args[0])
+	return i.GetAt(args, 0, 0)
+}
+
+// GetAt returns the value the key had at the given transaction or revision.
+// A zero atTx or atRevision means the parameter is not set, when both are unset
+// the revision specified in the key argument, if any, is used.
+func (i *immuc) GetAt(args []string, atTx uint64, atRevision int64) (string, error) {
+	if atTx > 0 && atRevision != 0 {
+		return "", errAtTxAndRevision
+	}
+
+	key, keyRevision, hasRevision, err := i.parseKeyArg(args[0])
 	if err != nil {
 		return "", err
 	}
 
+	if hasRevision {
+		if atTx > 0 || atRevision != 0 {
+			return "", errRevisionAlreadyInKey
+		}
+
+		atRevision = keyRevision
+	}
+
+	opt := client.AtRevision(atRevision)
+	if atTx > 0 {
+		opt = client.AtTx(atTx)
+	}
+
 	ctx := context.Background()
 	response, err := i.Execute(func(immuClient client.ImmuClient) (interface{}, error) {
-		return immuClient.Get(ctx, key, client.AtRevision(atRevision))
+		return immuClient.Get(ctx, key, opt)
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), "NotFound") {
@@ -155,3 +233,34 @@ func (i *immuc) VerifiedGet(args []string) (string, error) {
 	entry := response.(*schema.Entry)
 	return PrintKV(entry, true, i.options.valueOnly), nil
 }
+
+// VerifiedGetAll gets and verifies the items having the specified keys
+// against a single state, which is saved locally only once.
+func (i *immuc) VerifiedGetAll(args []string) (string, error) {
+	keys := make([][]byte, len(args))
+	for j, arg := range args {
+		keys[j] = []byte(arg)
+	}
+
+	ctx := context.Background()
+	response, err := i.Execute(func(immuClient client.ImmuClient) (interface{}, error) {
+		return immuClient.VerifiedGetAll(ctx, keys)
+	})
+	if err != nil {
+		rpcerrors := strings.SplitAfter(err.Error(), "=")
+		if len(rpcerrors) > 1 {
+			return rpcerrors[len(rpcerrors)-1], nil
+		}
+		return "", err
+	}
+
+	str := strings.Builder{}
+	for j, entry := range response.(*schema.Entries).Entries {
+		if j > 0 {
+			str.WriteString("\n")
+		}
+		str.WriteString(PrintKV(entry, true, i.options.valueOnly))
+	}
+
+	return str.String(), nil
+}
@@ -17,8 +17,14 @@ limitations under the License.
 package immuc_test
 
 import (
+	"bytes"
+	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,6 +38,78 @@ func TestGetTxByID(t *testing.T) {
 	require.NoError(t, err, "GetByIndex fail")
 	require.Contains(t, msg, "hash", "GetByIndex failed")
 }
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestTail(t *testing.T) {
+	ic := setupTest(t)
+
+	_, err := ic.Imc.Set([]string{"key1", "val1"})
+	require.NoError(t, err)
+
+	t.Run("only new transactions are written by default", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var out syncBuffer
+
+		done := make(chan error)
+		go func() {
+			done <- ic.Imc.Tail(ctx, 0, 10*time.Millisecond, &out)
+		}()
+
+		_, err := ic.Imc.Set([]string{"key2", "val2"})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return bytes.Contains([]byte(out.String()), []byte("key: key2"))
+		}, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-done)
+
+		require.NotContains(t, out.String(), "key: key1")
+	})
+
+	t.Run("transactions are written starting from the given one", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var out syncBuffer
+
+		done := make(chan error)
+		go func() {
+			done <- ic.Imc.Tail(ctx, 1, 10*time.Millisecond, &out)
+		}()
+
+		require.Eventually(t, func() bool {
+			return bytes.Contains([]byte(out.String()), []byte("key: key2"))
+		}, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-done)
+
+		require.Contains(t, out.String(), "key: key1")
+		require.Contains(t, out.String(), "tx: 1")
+	})
+
+	err = ic.Imc.Tail(context.Background(), 0, 0, &syncBuffer{})
+	require.Error(t, err)
+}
+
 func TestGet(t *testing.T) {
 	ic := setupTest(t)
 
@@ -54,6 +132,22 @@ func TestVerifiedGet(t *testing.T) {
 	require.Contains(t, msg, "value", "VerifiedGet failed")
 }
 
+func TestVerifiedGetAll(t *testing.T) {
+	ic := setupTest(t)
+
+	_, err := ic.Imc.Set([]string{"key1", "val1"})
+	require.NoError(t, err)
+
+	_, err = ic.Imc.Set([]string{"key2", "val2"})
+	require.NoError(t, err)
+
+	msg, err := ic.Imc.VerifiedGetAll([]string{"key1", "key2"})
+	require.NoError(t, err, "VerifiedGetAll fail")
+	require.Contains(t, msg, "val1", "VerifiedGetAll failed")
+	require.Contains(t, msg, "val2", "VerifiedGetAll failed")
+	require.Contains(t, msg, "verified", "VerifiedGetAll failed")
+}
+
 func TestGetByRevision(t *testing.T) {
 	ic := setupTest(t)
 
@@ -97,3 +191,45 @@ func TestGetByRevision(t *testing.T) {
 	_, err = ic.Imc.Get([]string{"key@notarevision"})
 	require.Error(t, err)
 }
+
+func TestGetAt(t *testing.T) {
+	ic := setupTest(t)
+
+	var txIDs []uint64
+	for _, value := range []string{"value1", "value2", "value3"} {
+		hdr, err := ic.Imc.Execute(func(immuClient client.ImmuClient) (interface{}, error) {
+			return immuClient.Set(context.Background(), []byte("key"), []byte(value))
+		})
+		require.NoError(t, err)
+		txIDs = append(txIDs, hdr.(*schema.TxHeader).Id)
+	}
+
+	msg, err := ic.Imc.GetAt([]string{"key"}, txIDs[0], 0)
+	require.NoError(t, err)
+	require.Contains(t, msg, "value1")
+
+	msg, err = ic.Imc.GetAt([]string{"key"}, txIDs[1], 0)
+	require.NoError(t, err)
+	require.Contains(t, msg, "value2")
+
+	msg, err = ic.Imc.GetAt([]string{"key"}, 0, 1)
+	require.NoError(t, err)
+	require.Contains(t, msg, "value1")
+
+	msg, err = ic.Imc.GetAt([]string{"key"}, 0, -1)
+	require.NoError(t, err)
+	require.Contains(t, msg, "value2")
+
+	msg, err = ic.Imc.GetAt([]string{"key"}, 0, 0)
+	require.NoError(t, err)
+	require.Contains(t, msg, "value3")
+
+	_, err = ic.Imc.GetAt([]string{"key"}, txIDs[0], 1)
+	require.Error(t, err)
+
+	_, err = ic.Imc.GetAt([]string{"key@1"}, txIDs[0], 0)
+	require.Error(t, err)
+
+	_, err = ic.Imc.GetAt([]string{"key@1"}, 0, 2)
+	require.Error(t, err)
+}
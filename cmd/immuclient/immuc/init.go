@@ -17,9 +17,12 @@ limitations under the License.
 package immuc
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/client/tokenservice"
 
@@ -46,8 +49,11 @@ type Client interface {
 	CurrentState(args []string) (string, error)
 	GetTxByID(args []string) (string, error)
 	VerifiedGetTxByID(args []string) (string, error)
+	Tail(ctx context.Context, fromTx uint64, interval time.Duration, out io.Writer) error
 	Get(args []string) (string, error)
+	GetAt(args []string, atTx uint64, atRevision int64) (string, error)
 	VerifiedGet(args []string) (string, error)
+	VerifiedGetAll(args []string) (string, error)
 	Login(args []string) (string, error)
 	Logout(args []string) (string, error)
 	History(args []string) (string, error)
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
 )
 
 // PrintKV ...
@@ -111,6 +112,43 @@ func PrintTx(tx *schema.Tx, verified bool) string {
 	return str.String()
 }
 
+// PrintTxSummary returns a compact description of the transaction along with the keys it wrote
+func PrintTxSummary(tx *schema.Tx) string {
+	str := strings.Builder{}
+	str.WriteString(fmt.Sprintf("tx: %d	time: %s	entries: %d\n", tx.Header.Id, time.Unix(int64(tx.Header.Ts), 0), tx.Header.Nentries))
+
+	var zEntries, sqlEntries, docEntries int
+
+	for _, e := range tx.Entries {
+		if len(e.Key) == 0 {
+			continue
+		}
+
+		switch e.Key[0] {
+		case database.SetKeyPrefix:
+			str.WriteString(fmt.Sprintf("	key: %s\n", e.Key[1:]))
+		case database.SortedSetKeyPrefix:
+			zEntries++
+		case database.SQLPrefix:
+			sqlEntries++
+		case database.DocumentPrefix:
+			docEntries++
+		}
+	}
+
+	if zEntries > 0 {
+		str.WriteString(fmt.Sprintf("	sorted set entries: %d\n", zEntries))
+	}
+	if sqlEntries > 0 {
+		str.WriteString(fmt.Sprintf("	sql entries: %d\n", sqlEntries))
+	}
+	if docEntries > 0 {
+		str.WriteString(fmt.Sprintf("	document entries: %d\n", docEntries))
+	}
+
+	return str.String()
+}
+
 // PadRight ...
 func PadRight(str, pad string, length int) string {
 	for {
@@ -18,6 +18,8 @@ package immudb
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -154,6 +156,39 @@ func TestImmudbCommandFlagParserPriority(t *testing.T) {
 	require.Equal(t, "override", options.Logfile)
 }
 
+func TestPKCS11PIN(t *testing.T) {
+	defer viper.Set("pkcs11-pin-file", "")
+
+	pin, err := pkcs11PIN()
+	require.NoError(t, err)
+	require.Empty(t, pin)
+
+	t.Setenv("IMMUDB_PKCS11_PIN", "1234")
+
+	pin, err = pkcs11PIN()
+	require.NoError(t, err)
+	require.Equal(t, "1234", pin)
+
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte("5678\n"), 0600))
+
+	viper.Set("pkcs11-pin-file", pinFile)
+
+	_, err = pkcs11PIN()
+	require.ErrorContains(t, err, "not both")
+
+	t.Setenv("IMMUDB_PKCS11_PIN", "")
+
+	pin, err = pkcs11PIN()
+	require.NoError(t, err)
+	require.Equal(t, "5678", pin)
+
+	viper.Set("pkcs11-pin-file", filepath.Join(t.TempDir(), "missing"))
+
+	_, err = pkcs11PIN()
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
 func executeCommand(root *cobra.Command, args ...string) (output string, err error) {
 	_, output, err = executeCommandC(root, args...)
 	return output, err
@@ -45,6 +45,9 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Bool("replication-skip-integrity-check", options.ReplicationOptions.SkipIntegrityCheck, "disable integrity check when reading data during replication")
 	cmd.Flags().Bool("replication-wait-for-indexing", options.ReplicationOptions.WaitForIndexing, "wait for indexing to be up to date during replication")
 	cmd.Flags().Int("max-active-databases", options.MaxActiveDatabases, "the maximum number of databases that can be active simultaneously")
+	cmd.Flags().Duration("document-expiration-interval", options.DocumentExpirationInterval, "how often expired documents are deleted from collections with a TTL (0 disables it)")
+	cmd.Flags().Int("max-documents-page-size", options.MaxDocumentsPageSize, "maximum number of documents returned by each page of a document search")
+	cmd.Flags().Bool("clamp-documents-page-size", options.ClampDocumentsPageSize, "serve document searches requesting a page size above the maximum using the maximum page size instead of rejecting them")
 	cmd.Flags().Int("max-key-length", options.MaxKeyLen, "engine-side max length (bytes) for indexed VARCHAR columns; 0 keeps the embedded/sql default (1024). Allowed range: [64, 65535]. The store-layer composite-key cap (default 1024 B) is the practical insert ceiling.")
 	cmd.Flags().String("config", "", "config file (default path are configs or $HOME. Default filename is immudb.toml)")
 
@@ -70,6 +73,11 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Bool("force-admin-password", false, "if true, reset the admin password to the one passed through admin-password option upon startup")
 	cmd.Flags().Bool("maintenance", options.GetMaintenance(), "override the authentication flag")
 	cmd.Flags().String("signingKey", options.SigningKey, "signature private key path. If a valid one is provided, it enables the cryptographic signature of the root. e.g. \"./../test/signer/ec3.key\"")
+	cmd.Flags().String("pkcs11-module", "", "path of the PKCS#11 library used to sign the state with a key stored in an HSM (requires a binary built with the pkcs11 tag)")
+	cmd.Flags().String("pkcs11-token-label", "", "label of the PKCS#11 token holding the signing key")
+	cmd.Flags().String("pkcs11-key-label", "", "label of the PKCS#11 signing key pair")
+	cmd.Flags().String("pkcs11-pin-file", "", "path of the file holding the user PIN of the PKCS#11 token. Alternatively the PIN can be set through the IMMUDB_PKCS11_PIN environment variable, it's not accepted as a flag to keep it out of the process list and the shell history")
+	cmd.Flags().String("timestamp-authority-url", "", "url of an RFC 3161 timestamp authority used to timestamp the signed state (requires signingKey or pkcs11-module). The authority is queried synchronously, with a 10s timeout, whenever a request returns a state not timestamped yet, and the request fails if no token is obtained")
 	cmd.Flags().Bool("synced", true, "synced mode prevents data lost under unexpected crashes but affects performance")
 	cmd.Flags().Int("token-expiry-time", options.TokenExpiryTimeMin, "client authentication token expiration time. Minutes")
 	cmd.Flags().Bool("metrics-server", options.MetricsServer, "enable or disable Prometheus endpoint")
@@ -97,6 +105,10 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Duration("max-session-inactivity-time", 3*time.Minute, "max session inactivity time is a duration after which an active session is declared inactive by the server. A session is kept active if server is still receiving requests from client (keep-alive or other methods)")
 	cmd.Flags().Duration("max-session-age-time", 0, "the current default value is infinity. max session age time is a duration after which session will be forcibly closed")
 	cmd.Flags().Duration("session-timeout", 2*time.Minute, "session timeout is a duration after which an inactive session is forcibly closed by the server")
+	cmd.Flags().Bool("session-inactivity-rollback", false, "roll back the read-write transactions of a session once it is declared inactive, the session and its read-only transactions are kept")
+	cmd.Flags().Duration("transaction-timeout", 0, "maximum duration of a read-write transaction, once exceeded the transaction is rolled back by the server (0 means no limit). Checked on every sessions guard run")
+	cmd.Flags().Duration("read-only-transaction-timeout", 0, "maximum duration of a read-only transaction, once exceeded the transaction is rolled back by the server (0 means no limit). Checked on every sessions guard run")
+	cmd.Flags().Bool("exclusive-read-write-tx", false, "allow a single read-write transaction per database across all sessions, further read-write transactions on the same database fail until it's committed or rolled back")
 	cmd.Flags().Duration("sessions-guard-check-interval", 1*time.Minute, "sessions guard check interval")
 	cmd.Flags().MarkHidden("sessions-guard-check-interval")
 	cmd.Flags().Bool("grpc-reflection", options.GRPCReflectionServerEnabled, "GRPC reflection server enabled")
@@ -104,6 +116,7 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Bool("log-request-metadata", options.LogRequestMetadata, "log request information in transaction metadata")
 	cmd.Flags().Bool("audit-log", options.AuditLog, "enable structured audit logging of all operations to an immutable audit trail")
 	cmd.Flags().String("audit-log-events", options.AuditLogEvents, "audit event filter: all, write, admin")
+	cmd.Flags().Bool("audit-log-document-payloads", options.AuditLogDocumentPayloads, "include the written documents in the audit events of document operations, otherwise only document ids and field names are recorded")
 
 	flagNameMapping := map[string]string{
 		"replication-enabled":           "replication-is-replica",
@@ -136,6 +149,7 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("log-access", options.LogAccess)
 	viper.SetDefault("audit-log", options.AuditLog)
 	viper.SetDefault("audit-log-events", options.AuditLogEvents)
+	viper.SetDefault("audit-log-document-payloads", options.AuditLogDocumentPayloads)
 	viper.SetDefault("mtls", false)
 	viper.SetDefault("auth", options.GetAuth())
 	viper.SetDefault("max-recv-msg-size", options.MaxRecvMsgSize)
@@ -174,7 +188,14 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("max-session-age-time", 0)
 	viper.SetDefault("max-active-databases", options.MaxActiveDatabases)
 	viper.SetDefault("max-key-length", options.MaxKeyLen)
+	viper.SetDefault("document-expiration-interval", options.DocumentExpirationInterval)
+	viper.SetDefault("max-documents-page-size", options.MaxDocumentsPageSize)
+	viper.SetDefault("clamp-documents-page-size", options.ClampDocumentsPageSize)
 	viper.SetDefault("session-timeout", 2*time.Minute)
+	viper.SetDefault("session-inactivity-rollback", false)
+	viper.SetDefault("transaction-timeout", 0)
+	viper.SetDefault("read-only-transaction-timeout", 0)
+	viper.SetDefault("exclusive-read-write-tx", false)
 	viper.SetDefault("sessions-guard-check-interval", 1*time.Minute)
 	viper.SetDefault("logformat", logger.LogFormatText)
 }
@@ -17,8 +17,13 @@ limitations under the License.
 package immudb
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/codenotary/immudb/pkg/server/sessions"
+	"github.com/codenotary/immudb/pkg/signer"
 	"github.com/spf13/viper"
 )
 
@@ -75,6 +80,24 @@ func parseOptions() (options *server.Options, err error) {
 	forceAdminPassword := viper.GetBool("force-admin-password")
 	maintenance := viper.GetBool("maintenance")
 	signingKey := viper.GetString("signingKey")
+
+	var pkcs11Signer *signer.PKCS11Options
+	if pkcs11Module := viper.GetString("pkcs11-module"); pkcs11Module != "" {
+		pin, err := pkcs11PIN()
+		if err != nil {
+			return nil, err
+		}
+
+		pkcs11Signer = &signer.PKCS11Options{
+			ModulePath: pkcs11Module,
+			TokenLabel: viper.GetString("pkcs11-token-label"),
+			KeyLabel:   viper.GetString("pkcs11-key-label"),
+			PIN:        pin,
+		}
+	}
+
+	timestampAuthorityURL := viper.GetString("timestamp-authority-url")
+
 	synced := viper.GetBool("synced")
 	tokenExpTime := viper.GetInt("token-expiry-time")
 
@@ -94,9 +117,13 @@ func parseOptions() (options *server.Options, err error) {
 	logRequestMetadata := viper.GetBool("log-request-metadata")
 	auditLog := viper.GetBool("audit-log")
 	auditLogEvents := viper.GetString("audit-log-events")
+	auditLogDocumentPayloads := viper.GetBool("audit-log-document-payloads")
 
 	maxActiveDatabases := viper.GetInt("max-active-databases")
 	maxKeyLen := viper.GetInt("max-key-length")
+	documentExpirationInterval := viper.GetDuration("document-expiration-interval")
+	maxDocumentsPageSize := viper.GetInt("max-documents-page-size")
+	clampDocumentsPageSize := viper.GetBool("clamp-documents-page-size")
 
 	s3Storage := viper.GetBool("s3-storage")
 	s3RoleEnabled := viper.GetBool("s3-role-enabled")
@@ -134,7 +161,11 @@ func parseOptions() (options *server.Options, err error) {
 		WithSessionGuardCheckInterval(viper.GetDuration("sessions-guard-check-interval")).
 		WithMaxSessionInactivityTime(viper.GetDuration("max-session-inactivity-time")).
 		WithMaxSessionAgeTime(viper.GetDuration("max-session-age-time")).
-		WithTimeout(viper.GetDuration("session-timeout"))
+		WithTimeout(viper.GetDuration("session-timeout")).
+		WithRollbackReadWriteTxOnInactivity(viper.GetBool("session-inactivity-rollback")).
+		WithTransactionTimeout(viper.GetDuration("transaction-timeout")).
+		WithReadOnlyTransactionTimeout(viper.GetDuration("read-only-transaction-timeout")).
+		WithExclusiveReadWriteTx(viper.GetBool("exclusive-read-write-tx"))
 
 	tlsConfig, err := setUpTLS(pkey, certificate, clientcas, mtls, autoCert)
 	if err != nil {
@@ -164,6 +195,8 @@ func parseOptions() (options *server.Options, err error) {
 		WithForceAdminPassword(forceAdminPassword).
 		WithMaintenance(maintenance).
 		WithSigningKey(signingKey).
+		WithPKCS11Signer(pkcs11Signer).
+		WithTimestampAuthorityURL(timestampAuthorityURL).
 		WithSynced(synced).
 		WithRemoteStorageOptions(remoteStorageOptions).
 		WithTokenExpiryTime(tokenExpTime).
@@ -182,7 +215,33 @@ func parseOptions() (options *server.Options, err error) {
 		WithMaxActiveDatabases(maxActiveDatabases).
 		WithMaxKeyLen(maxKeyLen).
 		WithAuditLog(auditLog).
-		WithAuditLogEvents(auditLogEvents)
+		WithAuditLogEvents(auditLogEvents).
+		WithAuditLogDocumentPayloads(auditLogDocumentPayloads).
+		WithDocumentExpirationInterval(documentExpirationInterval).
+		WithMaxDocumentsPageSize(maxDocumentsPageSize).
+		WithClampDocumentsPageSize(clampDocumentsPageSize)
 
 	return options, nil
 }
+
+// pkcs11PIN returns the user PIN of the PKCS#11 token, read either from the
+// IMMUDB_PKCS11_PIN environment variable or from the file set by pkcs11-pin-file
+func pkcs11PIN() (string, error) {
+	envPIN := os.Getenv("IMMUDB_PKCS11_PIN")
+
+	pinFile := viper.GetString("pkcs11-pin-file")
+	if pinFile == "" {
+		return envPIN, nil
+	}
+
+	if envPIN != "" {
+		return "", fmt.Errorf("the PKCS#11 PIN must be provided either through IMMUDB_PKCS11_PIN or pkcs11-pin-file, not both")
+	}
+
+	pin, err := os.ReadFile(pinFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the PKCS#11 PIN file: %w", err)
+	}
+
+	return strings.TrimRight(string(pin), "\r\n"), nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// BinaryCollation compares strings byte-wise, it's used when no collation is specified
+	BinaryCollation = "BINARY"
+	// NoCaseCollation compares strings ignoring the case of ASCII letters
+	NoCaseCollation = "NOCASE"
+)
+
+// canonicalCollation validates the name of a collation, which is either BINARY, NOCASE
+// or a BCP 47 language tag, and returns its canonical form. Byte-wise comparison is
+// returned as an empty string, as it's the collation of any field created without one.
+func canonicalCollation(name string) (string, error) {
+	switch strings.ToUpper(name) {
+	case "", BinaryCollation:
+		return "", nil
+	case NoCaseCollation:
+		return NoCaseCollation, nil
+	}
+
+	tag, err := language.Parse(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid collation '%s'", ErrIllegalArguments, name)
+	}
+
+	return tag.String(), nil
+}
+
+func collationName(collation string) string {
+	if collation == "" {
+		return BinaryCollation
+	}
+	return collation
+}
+
+func isLocaleCollation(collation string) bool {
+	return collation != "" && collation != NoCaseCollation
+}
+
+// columnCollation returns the collation of the STRING field stored in the column,
+// kept as the default value of the column, see the collection settings in quota.go.
+func columnCollation(col *sql.Column) string {
+	if col.Type() != sql.VarcharType || !col.HasDefault() {
+		return ""
+	}
+
+	defaultValue, ok := col.DefaultValue().(*sql.Varchar)
+	if !ok {
+		return ""
+	}
+	return defaultValue.RawValue().(string)
+}
+
+// indexCollation returns the collation shared by the STRING fields of the index
+func indexCollation(index *sql.Index) string {
+	for _, col := range index.Cols() {
+		if col.Type() == sql.VarcharType {
+			return columnCollation(col)
+		}
+	}
+	return ""
+}
+
+// collationKey returns the key the value is stored and compared as.
+// Locale-aware keys preserve the ordering of the language but not the prefixes of the values,
+// and they are several times longer than the values.
+func collationKey(collation, value string) string {
+	switch collation {
+	case "":
+		return value
+	case NoCaseCollation:
+		return asciiToLower(value)
+	}
+
+	pool := localeCollatorPool(collation)
+
+	c := pool.Get().(*localeCollator)
+	defer pool.Put(c)
+
+	key := string(c.collator.KeyFromString(&c.buf, value))
+	c.buf.Reset()
+
+	return key
+}
+
+// localeCollator pairs a collator with the buffer its keys are built in,
+// neither of them is safe for concurrent use
+type localeCollator struct {
+	collator *collate.Collator
+	buf      collate.Buffer
+}
+
+// localeCollators holds a pool of collators for each locale-aware collation,
+// as building a collator is far more expensive than computing a key
+var localeCollators sync.Map
+
+func localeCollatorPool(collation string) *sync.Pool {
+	if pool, ok := localeCollators.Load(collation); ok {
+		return pool.(*sync.Pool)
+	}
+
+	tag := language.Make(collation)
+
+	pool, _ := localeCollators.LoadOrStore(collation, &sync.Pool{
+		New: func() any {
+			return &localeCollator{collator: collate.New(tag)}
+		},
+	})
+
+	return pool.(*sync.Pool)
+}
+
+func asciiToLower(value string) string {
+	b := []byte(value)
+
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + ('a' - 'A')
+		}
+	}
+
+	return string(b)
+}
+
+// columnValue converts the value of a field into the value stored in its column,
+// string values are replaced by their collation key
+func columnValue(col *sql.Column, value *structpb.Value) (sql.ValueExp, error) {
+	val, err := structValueToSqlValue(value, col.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	collation := columnCollation(col)
+	if collation == "" {
+		return val, nil
+	}
+
+	strVal, ok := val.(*sql.Varchar)
+	if !ok {
+		return val, nil
+	}
+
+	return sql.NewVarchar(collationKey(collation, strVal.RawValue().(string))), nil
+}
+
+// storedColumnValue converts the value of a field into the value stored in its column.
+// The length of the column applies to the collation key, so values of fields using a
+// locale-aware collation are rejected well before reaching it.
+func storedColumnValue(col *sql.Column, value *structpb.Value) (sql.ValueExp, error) {
+	val, err := columnValue(col, value)
+	if err != nil {
+		return nil, err
+	}
+
+	collation := columnCollation(col)
+	if !isLocaleCollation(collation) {
+		return val, nil
+	}
+
+	key, ok := val.(*sql.Varchar)
+	if !ok {
+		return val, nil
+	}
+
+	if keyLen := len(key.RawValue().(string)); keyLen > col.MaxLen() {
+		return nil, fmt.Errorf("%w: value of %d bytes is encoded into %d bytes by collation '%s', the limit is %d bytes",
+			ErrMaxLengthExceeded, len(value.GetStringValue()), keyLen, collation, col.MaxLen())
+	}
+
+	return val, nil
+}
+
+// indexedFieldCollations returns the collation of every STRING field included in the indexes,
+// a field can not be indexed using different collations
+func indexedFieldCollations(fields []*protomodel.Field, indexes []*protomodel.Index) (map[string]string, error) {
+	stringFields := make(map[string]struct{})
+
+	for _, field := range fields {
+		if field.Type == protomodel.FieldType_STRING {
+			stringFields[field.Name] = struct{}{}
+		}
+	}
+
+	collations := make(map[string]string)
+
+	for _, index := range indexes {
+		collation, err := canonicalCollation(index.Collation)
+		if err != nil {
+			return nil, err
+		}
+
+		collated := false
+
+		for _, field := range index.Fields {
+			if _, ok := stringFields[field]; !ok {
+				continue
+			}
+
+			if fieldCollation, ok := collations[field]; ok && fieldCollation != collation {
+				return nil, fmt.Errorf("%w: field '%s' is indexed using different collations ('%s' and '%s')",
+					ErrIllegalArguments, field, collationName(fieldCollation), collationName(collation))
+			}
+
+			collations[field] = collation
+			collated = true
+		}
+
+		if collation != "" && !collated {
+			return nil, fmt.Errorf("%w: collation '%s' requires the index to include a STRING field", ErrIllegalArguments, collation)
+		}
+	}
+
+	return collations, nil
+}
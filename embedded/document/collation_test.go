@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestCanonicalCollation(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		canonical string
+	}{
+		{"", ""},
+		{"binary", ""},
+		{"BINARY", ""},
+		{"nocase", NoCaseCollation},
+		{"en", "en"},
+		{"de-de", "de-DE"},
+	} {
+		collation, err := canonicalCollation(c.name)
+		require.NoError(t, err)
+		require.Equal(t, c.canonical, collation)
+	}
+
+	_, err := canonicalCollation("not a collation")
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
+
+func TestCollationKey(t *testing.T) {
+	require.Equal(t, "Straße", collationKey("", "Straße"))
+	require.Equal(t, "straße", collationKey(NoCaseCollation, "StraßE"))
+
+	require.Less(t, collationKey("de", "Äpfel"), collationKey("de", "Birne"))
+	require.Less(t, collationKey("sv", "Zebra"), collationKey("sv", "Äpple"))
+}
+
+func TestCollationKeyConcurrency(t *testing.T) {
+	values := []string{"Äpfel", "Birne", "Müller-Lüdenscheidt", "Bahnhofstraße 12, 80335 München"}
+
+	expected := make([]string, len(values))
+	for i, v := range values {
+		expected[i] = string(collate.New(language.German).KeyFromString(&collate.Buffer{}, v))
+	}
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for n := 0; n < 100; n++ {
+				for i, v := range values {
+					require.Equal(t, expected[i], collationKey("de", v))
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Cursor is the position of a document within the results of a query.
+// It holds the values of the fields the query is sorted by and the id of the document,
+// so reading can be resumed right after the document without re-scanning the previous results.
+type Cursor struct {
+	Values     []*structpb.Value
+	DocumentID DocumentID
+}
+
+// NewCursor returns the cursor pointing to the given document, which must have been
+// read using the query.
+func (e *Engine) NewCursor(query *protomodel.Query, rev *protomodel.DocumentAtRevision) (*Cursor, error) {
+	if query == nil || rev == nil || rev.Document == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	docID, err := NewDocumentIDFromHexEncodedString(rev.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*structpb.Value, len(query.OrderBy))
+
+	for i, clause := range query.OrderBy {
+		val, err := e.structValueFromFieldPath(rev.Document, clause.Field)
+		if errors.Is(err, ErrFieldDoesNotExist) {
+			val = structpb.NewNullValue()
+		} else if err != nil {
+			return nil, err
+		}
+
+		values[i] = val
+	}
+
+	return &Cursor{
+		Values:     values,
+		DocumentID: docID,
+	}, nil
+}
+
+// Encode returns an opaque representation of the cursor
+func (c *Cursor) Encode() (string, error) {
+	list := &structpb.ListValue{
+		Values: append([]*structpb.Value{structpb.NewStringValue(c.DocumentID.EncodeToHexString())}, c.Values...),
+	}
+
+	bs, err := proto.Marshal(list)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// DecodeCursor parses a cursor previously encoded with Cursor.Encode
+func DecodeCursor(encodedCursor string) (*Cursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(encodedCursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	var list structpb.ListValue
+
+	err = proto.Unmarshal(bs, &list)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	if len(list.Values) == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	docID, err := NewDocumentIDFromHexEncodedString(list.Values[0].GetStringValue())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	return &Cursor{
+		Values:     list.Values[1:],
+		DocumentID: docID,
+	}, nil
+}
+
+// keysetOrderBy returns the sorting of the query with the document id appended as tie-breaker,
+// so every document has a unique position within the results
+func keysetOrderBy(table *sql.Table, orderBy []*protomodel.OrderByClause) []*protomodel.OrderByClause {
+	docIDField := docIDFieldName(table)
+
+	desc := false
+
+	for _, clause := range orderBy {
+		if clause.Field == docIDField {
+			return orderBy
+		}
+		desc = clause.Desc
+	}
+
+	keysetOrderBy := make([]*protomodel.OrderByClause, len(orderBy), len(orderBy)+1)
+	copy(keysetOrderBy, orderBy)
+
+	return append(keysetOrderBy, &protomodel.OrderByClause{Field: docIDField, Desc: desc})
+}
+
+// generateSQLKeysetExpression returns the condition matching the documents sorted after the cursor,
+// NULL values are sorted before any other value
+func generateSQLKeysetExpression(table *sql.Table, orderBy []*protomodel.OrderByClause, cursor *Cursor) (sql.ValueExp, error) {
+	if len(cursor.Values) != len(orderBy) {
+		return nil, fmt.Errorf("%w: the cursor does not match the sorting of the query", ErrInvalidCursor)
+	}
+
+	docIDField := docIDFieldName(table)
+
+	orderBy = keysetOrderBy(table, orderBy)
+
+	values := make([]sql.ValueExp, len(orderBy))
+
+	for i, clause := range orderBy {
+		column, err := getColumnForField(table, clause.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		if clause.Field == docIDField && i >= len(cursor.Values) {
+			values[i] = sql.NewBlob(cursor.DocumentID)
+			continue
+		}
+
+		values[i], err = columnValue(column, cursor.Values[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+	}
+
+	var keysetExp sql.ValueExp
+
+	for i, clause := range orderBy {
+		afterExp := sortedAfterExp(sql.NewColSelector(table.Name(), clause.Field), values[i], clause.Desc)
+		if afterExp == nil {
+			// no value is sorted after NULL in descending order
+			continue
+		}
+
+		for j := i - 1; j >= 0; j-- {
+			afterExp = sql.NewBinBoolExp(
+				sql.And,
+				sql.NewCmpBoolExp(sql.EQ, sql.NewColSelector(table.Name(), orderBy[j].Field), values[j]),
+				afterExp,
+			)
+		}
+
+		if keysetExp == nil {
+			keysetExp = afterExp
+		} else {
+			keysetExp = sql.NewBinBoolExp(sql.Or, keysetExp, afterExp)
+		}
+	}
+
+	if keysetExp == nil {
+		return sql.NewBool(false), nil
+	}
+
+	return keysetExp, nil
+}
+
+func sortedAfterExp(colSelector *sql.ColSelector, value sql.ValueExp, desc bool) sql.ValueExp {
+	_, isNull := value.(*sql.NullValue)
+
+	switch {
+	case isNull && desc:
+		return nil
+	case isNull:
+		return sql.NewCmpBoolExp(sql.NE, colSelector, value)
+	case desc:
+		return sql.NewBinBoolExp(
+			sql.Or,
+			sql.NewCmpBoolExp(sql.LT, colSelector, value),
+			sql.NewCmpBoolExp(sql.EQ, colSelector, sql.NewNull(sql.AnyType)),
+		)
+	default:
+		return sql.NewCmpBoolExp(sql.GT, colSelector, value)
+	}
+}
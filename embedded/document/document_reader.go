@@ -31,21 +31,30 @@ type DocumentReader interface {
 	Read(ctx context.Context) (*protomodel.DocumentAtRevision, error)
 	// ReadN reads n number of messages from a reader and returns them as a slice of Struct messages.
 	ReadN(ctx context.Context, count int) ([]*protomodel.DocumentAtRevision, error)
+	// FullScan returns true if the documents are searched by scanning all the documents
+	// of the collection, because no index range was selected to resolve the query conditions.
+	FullScan() bool
 	Close() error
 }
 
 type documentReader struct {
 	rowReader       sql.RowReader
+	fullScan        bool
 	onCloseCallback func(reader DocumentReader)
 }
 
-func newDocumentReader(rowReader sql.RowReader, onCloseCallback func(reader DocumentReader)) DocumentReader {
+func newDocumentReader(rowReader sql.RowReader, fullScan bool, onCloseCallback func(reader DocumentReader)) DocumentReader {
 	return &documentReader{
 		rowReader:       rowReader,
+		fullScan:        fullScan,
 		onCloseCallback: onCloseCallback,
 	}
 }
 
+func (r *documentReader) FullScan() bool {
+	return r.fullScan
+}
+
 // ReadN reads n number of messages from a reader and returns them as a slice of Struct messages.
 func (r *documentReader) ReadN(ctx context.Context, count int) ([]*protomodel.DocumentAtRevision, error) {
 	if count < 1 {
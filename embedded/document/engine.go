@@ -20,8 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -33,6 +37,7 @@ import (
 
 const (
 	DefaultDocumentIDField     = "_id"
+	DefaultTTLField            = "_expiresAt"
 	DocumentBLOBField          = "_doc"
 	documentFieldPathSeparator = "."
 )
@@ -52,6 +57,9 @@ type Engine struct {
 	sqlEngine *sql.Engine
 
 	maxNestedFields int
+
+	countersMutex    sync.Mutex
+	documentCounters map[uint32]*documentCounter
 }
 
 type EncodedDocument struct {
@@ -77,8 +85,9 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 	}
 
 	return &Engine{
-		sqlEngine:       engine,
-		maxNestedFields: opts.maxNestedFields,
+		sqlEngine:        engine,
+		maxNestedFields:  opts.maxNestedFields,
+		documentCounters: make(map[uint32]*documentCounter),
 	}, nil
 }
 
@@ -130,6 +139,41 @@ func validateFieldName(fieldName string) error {
 }
 
 func (e *Engine) CreateCollection(ctx context.Context, username, name, documentIdFieldName string, fields []*protomodel.Field, indexes []*protomodel.Index) error {
+	return e.CreateCollectionWithOptions(ctx, username, name, documentIdFieldName, fields, indexes, nil)
+}
+
+// CreateCollectionWithTTL creates a collection whose documents expire when the time
+// stored in the TTL field is reached. Expired documents are excluded from queries
+// and can be deleted with DeleteExpiredDocuments.
+//
+// The TTL field is created as an INTEGER column holding seconds since the unix epoch.
+// The default TTL is kept as the default value of the column, it's never used by the
+// sql engine as the value of the field is always provided when storing a document.
+func (e *Engine) CreateCollectionWithTTL(ctx context.Context, username, name, documentIdFieldName string, fields []*protomodel.Field, indexes []*protomodel.Index, ttl *protomodel.DocumentTTL) error {
+	return e.CreateCollectionWithOptions(ctx, username, name, documentIdFieldName, fields, indexes, &CollectionOptions{TTL: ttl})
+}
+
+// CollectionOptions holds the optional settings of a collection
+type CollectionOptions struct {
+	// TTL enables the expiration of the documents, see CreateCollectionWithTTL
+	TTL *protomodel.DocumentTTL
+	// StrictSchema restricts the documents to the declared fields, values must be of the declared types
+	StrictSchema bool
+	// MaxDocuments limits the number of documents the collection can hold, zero means no limit
+	MaxDocuments uint64
+}
+
+// CreateCollectionWithOptions creates a collection with the given optional settings.
+//
+// Strict schema and the maximum number of documents are kept as the default value of the
+// document column, see the collection settings in quota.go.
+func (e *Engine) CreateCollectionWithOptions(ctx context.Context, username, name, documentIdFieldName string, fields []*protomodel.Field, indexes []*protomodel.Index, collectionOpts *CollectionOptions) error {
+	if collectionOpts == nil {
+		collectionOpts = &CollectionOptions{}
+	}
+
+	ttl := collectionOpts.TTL
+
 	err := validateCollectionName(name)
 	if err != nil {
 		return err
@@ -144,6 +188,28 @@ func (e *Engine) CreateCollection(ctx context.Context, username, name, documentI
 		return err
 	}
 
+	var ttlFieldName string
+
+	if ttl != nil {
+		ttlFieldName = ttl.FieldName
+		if ttlFieldName == "" {
+			ttlFieldName = DefaultTTLField
+		}
+
+		err = validateTTLFieldName(ttlFieldName)
+		if err != nil {
+			return err
+		}
+
+		if ttlFieldName == documentIdFieldName {
+			return fmt.Errorf("%w: ttl field name '%s' should differ from id field name", ErrIllegalArguments, ttlFieldName)
+		}
+
+		if ttl.DefaultSeconds > math.MaxInt32 {
+			return fmt.Errorf("%w: default ttl exceeds the maximum allowed value (%d seconds)", ErrIllegalArguments, math.MaxInt32)
+		}
+	}
+
 	// only catalog needs to be up to date
 	opts := sql.DefaultTxOptions().
 		WithUnsafeMVCC(true).
@@ -152,13 +218,18 @@ func (e *Engine) CreateCollection(ctx context.Context, username, name, documentI
 		WithSnapshotRenewalPeriod(0).
 		WithExplicitClose(true)
 
+	fieldCollations, err := indexedFieldCollations(fields, indexes)
+	if err != nil {
+		return err
+	}
+
 	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
 	if err != nil {
 		return mayTranslateError(err)
 	}
 	defer sqlTx.Cancel()
 
-	columns := make([]*sql.ColSpec, 2+len(fields))
+	columns := make([]*sql.ColSpec, 2+len(fields), 3+len(fields))
 
 	// add primary key for document id
 	columns[0] = sql.NewColSpec(documentIdFieldName, sql.BLOBType, MaxDocumentIDLength, false, true)
@@ -166,6 +237,10 @@ func (e *Engine) CreateCollection(ctx context.Context, username, name, documentI
 	// add columnn for blob, which stores the document as a whole
 	columns[1] = sql.NewColSpec(DocumentBLOBField, sql.BLOBType, 0, false, false)
 
+	if settings := encodeDocumentColumnSettings(collectionOpts); settings != nil {
+		columns[1].WithDefaultValue(sql.NewBlob(settings))
+	}
+
 	for i, field := range fields {
 		err = validateFieldName(field.Name)
 		if err != nil {
@@ -187,6 +262,29 @@ func (e *Engine) CreateCollection(ctx context.Context, username, name, documentI
 		}
 
 		columns[i+2] = sql.NewColSpec(field.Name, sqlType, colLen, false, false)
+
+		if collation := fieldCollations[field.Name]; collation != "" {
+			columns[i+2].WithDefaultValue(sql.NewVarchar(collation))
+		}
+
+		if field.Name == ttlFieldName {
+			if sqlType != sql.IntegerType {
+				return fmt.Errorf("%w: ttl field '%s' must be of type INTEGER", ErrIllegalArguments, field.Name)
+			}
+
+			columns[i+2].WithDefaultValue(sql.NewInteger(int64(ttl.DefaultSeconds)))
+			ttlFieldName = ""
+		}
+	}
+
+	if ttlFieldName != "" {
+		colLen, err := sqlValueTypeDefaultLength(sql.IntegerType)
+		if err != nil {
+			return err
+		}
+
+		columns = append(columns, sql.NewColSpec(ttlFieldName, sql.IntegerType, colLen, false, false).
+			WithDefaultValue(sql.NewInteger(int64(ttl.DefaultSeconds))))
 	}
 
 	_, _, err = e.sqlEngine.ExecPreparedStmts(
@@ -265,6 +363,61 @@ func (e *Engine) GetCollection(ctx context.Context, collectionName string) (*pro
 	return collectionFromTable(table), nil
 }
 
+// GetCollectionStats returns the number of documents in the collection and the
+// number of entries, distinct keys and approximate size of each of its indexes.
+// Statistics are collected from index entries, document payloads are not read.
+func (e *Engine) GetCollectionStats(ctx context.Context, collectionName string) (*protomodel.CollectionStats, error) {
+	opts := sql.DefaultTxOptions().
+		WithReadOnly(true).
+		WithExplicitClose(true)
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := table.GetIndexes()
+
+	stats := &protomodel.CollectionStats{
+		Name:         table.Name(),
+		Indexes:      make([]*protomodel.IndexStats, len(indexes)),
+		MaxDocuments: maxDocuments(table),
+	}
+
+	for i, index := range indexes {
+		indexStats, err := sqlTx.IndexStats(ctx, index)
+		if err != nil {
+			return nil, mayTranslateError(err)
+		}
+
+		fields := make([]string, len(index.Cols()))
+
+		for j, col := range index.Cols() {
+			fields[j] = col.Name()
+		}
+
+		if index.IsPrimary() {
+			stats.DocumentCount = indexStats.Entries
+		}
+
+		stats.Indexes[i] = &protomodel.IndexStats{
+			Fields:       fields,
+			IsUnique:     index.IsUnique(),
+			Entries:      indexStats.Entries,
+			DistinctKeys: indexStats.DistinctKeys,
+			Size:         indexStats.Size,
+		}
+	}
+
+	return stats, nil
+}
+
 func (e *Engine) GetCollections(ctx context.Context) ([]*protomodel.Collection, error) {
 	opts := sql.DefaultTxOptions().
 		WithReadOnly(true).
@@ -291,6 +444,141 @@ func docIDFieldName(table *sql.Table) string {
 	return table.PrimaryIndex().Cols()[0].Name()
 }
 
+// ttlColumn returns the column holding the expiration time of the documents,
+// it is the only INTEGER column with a default value, see the collection settings in quota.go.
+func ttlColumn(table *sql.Table) *sql.Column {
+	for _, col := range table.Cols() {
+		if col.HasDefault() && col.Type() == sql.IntegerType {
+			return col
+		}
+	}
+	return nil
+}
+
+func ttlDefaultSeconds(col *sql.Column) int64 {
+	defaultValue, ok := col.DefaultValue().(*sql.Integer)
+	if !ok {
+		return 0
+	}
+	return defaultValue.RawValue().(int64)
+}
+
+// isStrictSchema returns true if the collection only accepts documents consisting of the declared fields
+func isStrictSchema(table *sql.Table) bool {
+	settings := documentColumnSettings(table)
+	return len(settings) > 0 && settings[0]&strictSchemaFlag != 0
+}
+
+// validateStrictSchema checks that every field of the document is declared in the collection
+// and holds a value of the declared type. Nested documents are only accepted if some of their
+// fields are declared (e.g. field "address" holding a document when "address.city" is declared).
+func validateStrictSchema(table *sql.Table, doc *structpb.Struct, parentPath string) error {
+	docIDFieldName := docIDFieldName(table)
+
+	fieldNames := make([]string, 0, len(doc.Fields))
+	for fieldName := range doc.Fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+
+	// the first offending field is always the same one
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		value := doc.Fields[fieldName]
+
+		fieldPath := fieldName
+		if parentPath != "" {
+			fieldPath = parentPath + documentFieldPathSeparator + fieldName
+		}
+
+		if fieldPath == docIDFieldName {
+			// the document id is validated when the document is stored
+			continue
+		}
+
+		col, err := table.GetColumnByName(fieldPath)
+		if err == nil {
+			err = validateStrictFieldValue(col, value)
+			if err != nil {
+				return fmt.Errorf("%w: field '%s': %v", ErrSchemaViolation, fieldPath, err)
+			}
+			continue
+		}
+
+		nestedDoc := value.GetStructValue()
+		if nestedDoc != nil && hasNestedFields(table, fieldPath) {
+			err := validateStrictSchema(table, nestedDoc, fieldPath)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		return fmt.Errorf("%w: field '%s' is not declared", ErrSchemaViolation, fieldPath)
+	}
+
+	return nil
+}
+
+func validateStrictFieldValue(col *sql.Column, value *structpb.Value) error {
+	_, err := structValueToSqlValue(value, col.Type())
+	if err != nil {
+		return err
+	}
+
+	if col.Type() == sql.IntegerType {
+		if n, ok := value.GetKind().(*structpb.Value_NumberValue); ok && n.NumberValue != math.Trunc(n.NumberValue) {
+			return fmt.Errorf("%w: expecting value of type %s", ErrUnexpectedValue, sql.IntegerType)
+		}
+	}
+
+	return nil
+}
+
+func hasNestedFields(table *sql.Table, fieldPath string) bool {
+	for _, col := range table.Cols() {
+		if strings.HasPrefix(col.Name(), fieldPath+documentFieldPathSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTTLFieldName(fieldName string) error {
+	err := validateFieldName(fieldName)
+	if err != nil {
+		return err
+	}
+
+	if strings.Contains(fieldName, documentFieldPathSeparator) {
+		return fmt.Errorf("%w: invalid ttl field name '%s'", ErrIllegalArguments, fieldName)
+	}
+
+	return nil
+}
+
+// withExpirationFilter restricts the condition to the documents not expired at the given time
+func withExpirationFilter(table *sql.Table, exp sql.ValueExp, now time.Time) sql.ValueExp {
+	col := ttlColumn(table)
+	if col == nil {
+		return exp
+	}
+
+	colSelector := sql.NewColSelector(table.Name(), col.Name())
+
+	notExpiredExp := sql.NewBinBoolExp(
+		sql.Or,
+		sql.NewCmpBoolExp(sql.EQ, colSelector, sql.NewNull(sql.AnyType)),
+		sql.NewCmpBoolExp(sql.GT, colSelector, sql.NewInteger(now.Unix())),
+	)
+
+	if exp == nil {
+		return notExpiredExp
+	}
+
+	return sql.NewBinBoolExp(sql.And, exp, notExpiredExp)
+}
+
 func getTableForCollection(sqlTx *sql.SQLTx, collectionName string) (*sql.Table, error) {
 	err := validateCollectionName(collectionName)
 	if err != nil {
@@ -360,6 +648,16 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 		})
 	}
 
+	if col := ttlColumn(table); col != nil {
+		collection.Ttl = &protomodel.DocumentTTL{
+			FieldName:      col.Name(),
+			DefaultSeconds: uint64(ttlDefaultSeconds(col)),
+		}
+	}
+
+	collection.StrictSchema = isStrictSchema(table)
+	collection.MaxDocuments = maxDocuments(table)
+
 	for i, index := range indexes {
 		fields := make([]string, len(index.Cols()))
 
@@ -368,8 +666,9 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 		}
 
 		collection.Indexes[i] = &protomodel.Index{
-			Fields:   fields,
-			IsUnique: index.IsUnique(),
+			Fields:    fields,
+			IsUnique:  index.IsUnique(),
+			Collation: indexCollation(index),
 		}
 	}
 
@@ -451,6 +750,11 @@ func (e *Engine) DeleteCollection(ctx context.Context, username, collectionName
 	}
 	defer sqlTx.Cancel()
 
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return err
+	}
+
 	_, _, err = e.sqlEngine.ExecPreparedStmts(
 		ctx,
 		sqlTx,
@@ -464,7 +768,13 @@ func (e *Engine) DeleteCollection(ctx context.Context, username, collectionName
 	}
 
 	err = sqlTx.Commit(ctx)
-	return mayTranslateError(err)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	e.removeDocumentCounter(table.ID())
+
+	return nil
 }
 
 func (e *Engine) AddField(ctx context.Context, username, collectionName string, field *protomodel.Field) error {
@@ -563,12 +873,27 @@ func (e *Engine) RemoveField(ctx context.Context, username, collectionName strin
 	return mayTranslateError(err)
 }
 
+// CreateIndex creates an index on existing fields of the collection. The index is built
+// in background over the documents already stored, indexing progress is persisted so it's
+// resumed after a restart. Queries reading after the creation wait for the index to catch up.
+// Unique indexes can only be created on empty collections.
 func (e *Engine) CreateIndex(ctx context.Context, username, collectionName string, fields []string, isUnique bool) error {
+	return e.CreateIndexWithCollation(ctx, username, collectionName, fields, isUnique, "")
+}
+
+// CreateIndexWithCollation creates an index whose STRING fields are compared using the given collation.
+// The collation of a field is fixed when the collection is created, so it must match the one of the fields.
+func (e *Engine) CreateIndexWithCollation(ctx context.Context, username, collectionName string, fields []string, isUnique bool, collation string) error {
 	err := validateCollectionName(collectionName)
 	if err != nil {
 		return err
 	}
 
+	collation, err = canonicalCollation(collation)
+	if err != nil {
+		return err
+	}
+
 	if len(fields) == 0 {
 		return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
 	}
@@ -593,6 +918,35 @@ func (e *Engine) CreateIndex(ctx context.Context, username, collectionName strin
 		}
 	}
 
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	collated := false
+
+	for _, field := range fields {
+		column, err := getColumnForField(table, field)
+		if err != nil {
+			return err
+		}
+
+		if column.Type() != sql.VarcharType {
+			continue
+		}
+
+		if fieldCollation := columnCollation(column); fieldCollation != collation {
+			return fmt.Errorf("%w: field '%s' uses collation '%s' and can not be indexed using collation '%s'",
+				ErrIllegalArguments, field, collationName(fieldCollation), collationName(collation))
+		}
+
+		collated = true
+	}
+
+	if collation != "" && !collated {
+		return fmt.Errorf("%w: collation '%s' requires the index to include a STRING field", ErrIllegalArguments, collation)
+	}
+
 	createIndexStmt := sql.NewCreateIndexStmt(collectionName, fields, isUnique)
 
 	_, _, err = e.sqlEngine.ExecPreparedStmts(
@@ -692,6 +1046,18 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 
 	docIDFieldName := docIDFieldName(table)
 
+	var ttlFieldName string
+	var ttlSeconds int64
+
+	if col := ttlColumn(table); col != nil {
+		ttlFieldName = col.Name()
+		ttlSeconds = ttlDefaultSeconds(col)
+	}
+
+	strictSchema := isStrictSchema(table)
+
+	now := time.Now()
+
 	colNames := make([]string, len(table.Cols()))
 
 	for i, col := range table.Cols() {
@@ -734,6 +1100,18 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 			doc.Fields[docIDFieldName] = structpb.NewStringValue(docID.EncodeToHexString())
 		}
 
+		if strictSchema {
+			err = validateStrictSchema(table, doc, "")
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+
+		if _, expirationProvisioned := doc.Fields[ttlFieldName]; ttlSeconds > 0 && !expirationProvisioned {
+			// documents stored without an expiration time get the default one
+			doc.Fields[ttlFieldName] = structpb.NewNumberValue(float64(now.Unix() + ttlSeconds))
+		}
+
 		rowSpec, err := e.generateRowSpecForDocument(table, doc)
 		if err != nil {
 			return 0, nil, err
@@ -743,6 +1121,46 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 		rows[i] = rowSpec
 	}
 
+	if limit := maxDocuments(table); limit > 0 {
+		counter := e.documentCounter(table)
+
+		// the lock is held until the documents are committed
+		counter.mutex.Lock()
+		defer counter.mutex.Unlock()
+
+		// err is not redeclared, the counter is increased only if the named result is nil
+		var count uint64
+		count, err = e.loadedCount(ctx, counter, table)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		newDocs := uint64(len(docs))
+
+		if !isInsert {
+			// replaced or imported documents may not be new ones,
+			// the counter is reloaded once they are stored
+			defer func() { counter.loaded = false }()
+
+			newDocs, err = e.countNewDocuments(ctx, sqlTx, table, docIDs)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+
+		if count+newDocs > limit {
+			return 0, nil, fmt.Errorf("%w: collection '%s' can hold up to %d documents", ErrDocumentQuotaExceeded, collectionName, limit)
+		}
+
+		if isInsert {
+			defer func() {
+				if err == nil {
+					counter.count += newDocs
+				}
+			}()
+		}
+	}
+
 	// add documents to collection
 	_, ctxs, err := e.sqlEngine.ExecPreparedStmts(
 		ctx,
@@ -758,6 +1176,10 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 		},
 		nil,
 	)
+	if isInsert && errors.Is(err, store.ErrKeyAlreadyExists) {
+		// the document clashes with a stored one, either by id or by a unique index
+		return 0, nil, fmt.Errorf("%w (%s)", ErrDocumentAlreadyExists, collectionName)
+	}
 	if err != nil {
 		return 0, nil, mayTranslateError(err)
 	}
@@ -789,7 +1211,7 @@ func (e *Engine) generateRowSpecForDocument(table *sql.Table, doc *structpb.Stru
 		if rval == nil {
 			values[i] = &sql.NullValue{}
 		} else {
-			val, err := structValueToSqlValue(rval, col.Type())
+			val, err := storedColumnValue(col, rval)
 			if err != nil {
 				return nil, fmt.Errorf("%w: field: %s", err, col.Name())
 			}
@@ -881,7 +1303,7 @@ func (e *Engine) ReplaceDocuments(ctx context.Context, username string, query *p
 	queryStmt := sql.NewSelectStmt(
 		[]sql.TargetEntry{{Exp: sql.NewColSelector(query.CollectionName, documentIdFieldName)}},
 		sql.NewTableRef(query.CollectionName, ""),
-		queryCondition,
+		withExpirationFilter(table, queryCondition, time.Now()),
 		generateSQLOrderByClauses(table, query.OrderBy),
 		sql.NewInteger(int64(query.Limit)),
 		nil,
@@ -960,49 +1382,126 @@ func (e *Engine) ReplaceDocuments(ctx context.Context, username string, query *p
 }
 
 func (e *Engine) GetDocuments(ctx context.Context, query *protomodel.Query, offset int64) (DocumentReader, error) {
+	return e.getDocuments(ctx, query, 0, offset, nil)
+}
+
+// GetDocumentsAtTx returns the documents matching the query as they were once the transaction atTx was committed,
+// results are thus not affected by later changes. The latest documents are returned when atTx is zero.
+func (e *Engine) GetDocumentsAtTx(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64) (DocumentReader, error) {
+	return e.getDocuments(ctx, query, atTx, offset, nil)
+}
+
+// GetDocumentsAfter returns the documents matching the query sorted after the cursor,
+// when no cursor is provided documents are returned from the beginning.
+// Documents are additionally sorted by id, thus each document gets a stable position
+// from which reading can be resumed using a cursor created with NewCursor.
+func (e *Engine) GetDocumentsAfter(ctx context.Context, query *protomodel.Query, cursor *Cursor) (DocumentReader, error) {
+	return e.GetDocumentsAfterAtTx(ctx, query, 0, cursor)
+}
+
+// GetDocumentsAfterAtTx is the same as GetDocumentsAfter but documents are read as they were
+// once the transaction atTx was committed, so pages read with the same atTx are consistent with each other
+func (e *Engine) GetDocumentsAfterAtTx(ctx context.Context, query *protomodel.Query, atTx uint64, cursor *Cursor) (DocumentReader, error) {
 	if query == nil {
 		return nil, ErrIllegalArguments
 	}
 
-	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if cursor == nil {
+		cursor = &Cursor{}
+	}
+
+	return e.getDocuments(ctx, query, atTx, 0, cursor)
+}
+
+func (e *Engine) getDocuments(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64, cursor *Cursor) (DocumentReader, error) {
+	sqlTx, op, err := e.documentsQueryStmt(ctx, query, atTx, offset, cursor)
 	if err != nil {
-		return nil, mayTranslateError(err)
+		return nil, err
 	}
 
-	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	// returning an open reader here, so the caller HAS to close it
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
 	if err != nil {
 		defer sqlTx.Cancel()
 		return nil, err
 	}
 
+	return newDocumentReader(r, isFullScan(query, r.ScanSpecs()), func(_ DocumentReader) { sqlTx.Cancel() }), nil
+}
+
+// documentsQueryStmt returns the statement selecting the documents matching the query,
+// along with the read-only transaction it has to be run in, which must be cancelled by the caller
+func (e *Engine) documentsQueryStmt(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64, cursor *Cursor) (*sql.SQLTx, *sql.SelectStmt, error) {
+	if query == nil {
+		return nil, nil, ErrIllegalArguments
+	}
+
+	opts := sql.DefaultTxOptions().WithReadOnly(true)
+
+	if atTx > 0 {
+		// the snapshot must include the transaction, otherwise its documents could be missed
+		opts.WithSnapshotMustIncludeTxID(func(_ uint64) uint64 {
+			return atTx
+		})
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return nil, nil, mayTranslateError(err)
+	}
+
+	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	if err != nil {
+		defer sqlTx.Cancel()
+		return nil, nil, err
+	}
+
 	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
 	if err != nil {
 		defer sqlTx.Cancel()
-		return nil, err
+		return nil, nil, err
+	}
+
+	orderBy := query.OrderBy
+
+	if cursor != nil {
+		orderBy = keysetOrderBy(table, query.OrderBy)
+
+		if cursor.DocumentID != nil {
+			keysetCondition, err := generateSQLKeysetExpression(table, query.OrderBy, cursor)
+			if err != nil {
+				defer sqlTx.Cancel()
+				return nil, nil, err
+			}
+
+			if queryCondition == nil {
+				queryCondition = keysetCondition
+			} else {
+				queryCondition = sql.NewBinBoolExp(sql.And, queryCondition, keysetCondition)
+			}
+		}
 	}
 
 	docIDField := docIDFieldName(table)
 
+	tableRef := sql.NewTableRef(query.CollectionName, "")
+	if atTx > 0 {
+		tableRef = tableRef.UntilTx(atTx)
+	}
+
 	op := sql.NewSelectStmt(
 		[]sql.TargetEntry{
 			{Exp: sql.NewColSelector(query.CollectionName, docIDField)},
 			{Exp: sql.NewColSelector(query.CollectionName, DocumentBLOBField)},
 		},
-		sql.NewTableRef(query.CollectionName, ""),
-		queryCondition,
-		generateSQLOrderByClauses(table, query.OrderBy),
+		tableRef,
+		withExpirationFilter(table, queryCondition, time.Now()),
+		generateSQLOrderByClauses(table, orderBy),
 		sql.NewInteger(int64(query.Limit)),
 		sql.NewInteger(offset),
-	)
+	).WithIndexOn(prefixScanIndex(table, query))
 
-	// returning an open reader here, so the caller HAS to close it
-	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
-	if err != nil {
-		defer sqlTx.Cancel()
-		return nil, err
-	}
-
-	return newDocumentReader(r, func(_ DocumentReader) { sqlTx.Cancel() }), nil
+	return sqlTx, op, nil
 }
 
 func (e *Engine) CountDocuments(ctx context.Context, query *protomodel.Query, offset int64) (int64, error) {
@@ -1030,7 +1529,7 @@ func (e *Engine) CountDocuments(ctx context.Context, query *protomodel.Query, of
 	ds := sql.NewSelectStmt(
 		[]sql.TargetEntry{{Exp: sql.NewColSelector(query.CollectionName, table.Cols()[0].Name())}},
 		sql.NewTableRef(query.CollectionName, ""),
-		queryCondition,
+		withExpirationFilter(table, queryCondition, time.Now()),
 		generateSQLOrderByClauses(table, query.OrderBy),
 		sql.NewInteger(int64(query.Limit)),
 		sql.NewInteger(offset),
@@ -1148,23 +1647,55 @@ func generateSQLFilteringExpression(expressions []*protomodel.QueryExpression, t
 				return nil, err
 			}
 
-			value, err := structValueToSqlValue(exp.Value, column.Type())
+			collation := columnCollation(column)
+
+			if exp.Collation != "" {
+				queryCollation, err := canonicalCollation(exp.Collation)
+				if err != nil {
+					return nil, err
+				}
+
+				if queryCollation != collation {
+					return nil, fmt.Errorf("%w: field '%s' uses collation '%s' and can not be compared using collation '%s'",
+						ErrIllegalArguments, exp.Field, collationName(collation), collationName(queryCollation))
+				}
+			}
+
+			value, err := columnValue(column, exp.Value)
 			if err != nil {
 				return nil, err
 			}
 
 			colSelector := sql.NewColSelector(table.Name(), exp.Field)
 
+			if exp.CaseInsensitive && !isTextMatchingOperator(exp.Operator) {
+				return nil, fmt.Errorf("%w: case insensitive matching is not supported by operator ('%s')", ErrInvalidQueryOperator, exp.Operator)
+			}
+
+			if isLocaleCollation(collation) && isTextMatchingOperator(exp.Operator) {
+				return nil, fmt.Errorf("%w: operator ('%s') is not supported on fields using collation '%s'", ErrInvalidQueryOperator, exp.Operator, collation)
+			}
+
 			var fieldExp sql.ValueExp
 
 			switch exp.Operator {
-			case protomodel.ComparisonOperator_LIKE:
+			case protomodel.ComparisonOperator_LIKE, protomodel.ComparisonOperator_NOT_LIKE:
 				{
-					fieldExp = sql.NewLikeBoolExp(colSelector, false, value)
+					notLike := exp.Operator == protomodel.ComparisonOperator_NOT_LIKE
+
+					if exp.CaseInsensitive {
+						fieldExp = sql.NewILikeBoolExp(colSelector, notLike, value)
+					} else {
+						fieldExp = sql.NewLikeBoolExp(colSelector, notLike, value)
+					}
 				}
-			case protomodel.ComparisonOperator_NOT_LIKE:
+			case protomodel.ComparisonOperator_PREFIX, protomodel.ComparisonOperator_CONTAINS:
 				{
-					fieldExp = sql.NewLikeBoolExp(colSelector, true, value)
+					if column.Type() != sql.VarcharType {
+						return nil, fmt.Errorf("%w: operator ('%s') is only supported on STRING fields", ErrInvalidQueryOperator, exp.Operator)
+					}
+
+					fieldExp = textMatchingExp(colSelector, exp.Operator, collationKey(collation, exp.Value.GetStringValue()), exp.CaseInsensitive)
 				}
 			default:
 				{
@@ -1194,6 +1725,122 @@ func generateSQLFilteringExpression(expressions []*protomodel.QueryExpression, t
 	return outerExp, nil
 }
 
+func isTextMatchingOperator(op protomodel.ComparisonOperator) bool {
+	switch op {
+	case protomodel.ComparisonOperator_LIKE,
+		protomodel.ComparisonOperator_NOT_LIKE,
+		protomodel.ComparisonOperator_PREFIX,
+		protomodel.ComparisonOperator_CONTAINS:
+		return true
+	}
+	return false
+}
+
+// textMatchingExp generates the expression for PREFIX and CONTAINS comparisons.
+// A case sensitive PREFIX comparison is translated into a range over the field
+// so it can be resolved with an index range scan, any other comparison is
+// translated into a LIKE expression evaluated over every scanned document.
+func textMatchingExp(colSelector *sql.ColSelector, op protomodel.ComparisonOperator, value string, caseInsensitive bool) sql.ValueExp {
+	if op == protomodel.ComparisonOperator_PREFIX && !caseInsensitive {
+		lowerBoundExp := sql.NewCmpBoolExp(sql.GE, colSelector, sql.NewVarchar(value))
+
+		upperBound, bounded := prefixUpperBound(value)
+		if !bounded {
+			return lowerBoundExp
+		}
+
+		return sql.NewBinBoolExp(
+			sql.And,
+			lowerBoundExp,
+			sql.NewCmpBoolExp(sql.LT, colSelector, sql.NewVarchar(upperBound)),
+		)
+	}
+
+	pattern := escapeLikePattern(value) + "%"
+	if op == protomodel.ComparisonOperator_CONTAINS {
+		pattern = "%" + pattern
+	}
+
+	if caseInsensitive {
+		return sql.NewILikeBoolExp(colSelector, false, sql.NewVarchar(pattern))
+	}
+
+	return sql.NewLikeBoolExp(colSelector, false, sql.NewVarchar(pattern))
+}
+
+// prefixUpperBound returns the smallest string greater than any string starting
+// with the given prefix. There is no such string when the prefix is empty or
+// made only of 0xFF bytes.
+func prefixUpperBound(prefix string) (string, bool) {
+	b := []byte(prefix)
+
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < 0xFF {
+			b[i]++
+			return string(b[:i+1]), true
+		}
+	}
+
+	return "", false
+}
+
+func escapeLikePattern(value string) string {
+	var b strings.Builder
+
+	for _, c := range value {
+		if c == '\\' || c == '%' || c == '_' {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// isFullScan returns true if the query includes comparisons but the scan chosen to resolve
+// it is not restricted to a range of an index, hence requiring all the documents in the
+// collection to be scanned. It's derived from the plan, as whether an index is selected
+// depends on the indexes of the collection and not only on the comparison operators.
+func isFullScan(query *protomodel.Query, scanSpecs *sql.ScanSpecs) bool {
+	for _, exp := range query.GetExpressions() {
+		if len(exp.FieldComparisons) > 0 {
+			return len(scanSpecs.IndexRanges()) == 0
+		}
+	}
+	return false
+}
+
+// prefixScanIndex returns the fields of an index which can be used to resolve a
+// case sensitive PREFIX comparison with a range scan. The query planner only
+// picks an index by itself when it can be used for sorting, so the index is
+// only returned for unsorted queries made of a single expression.
+func prefixScanIndex(table *sql.Table, query *protomodel.Query) []string {
+	if len(query.Expressions) != 1 || len(query.OrderBy) > 0 {
+		return nil
+	}
+
+	for _, cmp := range query.Expressions[0].FieldComparisons {
+		if cmp.Operator != protomodel.ComparisonOperator_PREFIX || cmp.CaseInsensitive {
+			continue
+		}
+
+		for _, index := range table.GetIndexes() {
+			if index.IsPrimary() || index.Cols()[0].Name() != cmp.Field {
+				continue
+			}
+
+			fields := make([]string, len(index.Cols()))
+			for i, col := range index.Cols() {
+				fields[i] = col.Name()
+			}
+
+			return fields
+		}
+	}
+
+	return nil
+}
+
 func sqlCmpOperatorFor(op protomodel.ComparisonOperator) (sql.CmpOperator, error) {
 	switch op {
 	case protomodel.ComparisonOperator_EQ:
@@ -1222,7 +1869,7 @@ func sqlCmpOperatorFor(op protomodel.ComparisonOperator) (sql.CmpOperator, error
 		}
 	default:
 		{
-			return 0, fmt.Errorf("%w: unsupported operator ('%s')", ErrIllegalArguments, op)
+			return 0, fmt.Errorf("%w: unsupported operator ('%s')", ErrInvalidQueryOperator, op)
 		}
 	}
 }
@@ -1395,7 +2042,22 @@ func (e *Engine) DeleteDocuments(ctx context.Context, username string, query *pr
 		sql.NewInteger(int64(query.Limit)),
 	)
 
-	_, _, err = e.sqlEngine.ExecPreparedStmts(
+	return e.deleteDocuments(ctx, sqlTx, table, deleteStmt)
+}
+
+// deleteDocuments executes the deletion and keeps the document counter
+// of collections with a quota up to date
+func (e *Engine) deleteDocuments(ctx context.Context, sqlTx *sql.SQLTx, table *sql.Table, deleteStmt sql.SQLStmt) error {
+	var counter *documentCounter
+
+	if maxDocuments(table) > 0 {
+		counter = e.documentCounter(table)
+
+		counter.mutex.Lock()
+		defer counter.mutex.Unlock()
+	}
+
+	_, ctxs, err := e.sqlEngine.ExecPreparedStmts(
 		ctx,
 		sqlTx,
 		[]sql.SQLStmt{deleteStmt},
@@ -1405,6 +2067,66 @@ func (e *Engine) DeleteDocuments(ctx context.Context, username string, query *pr
 		return mayTranslateError(err)
 	}
 
+	if counter != nil && counter.loaded {
+		for _, tx := range ctxs {
+			deleted := uint64(tx.UpdatedRows())
+
+			if deleted > counter.count {
+				counter.loaded = false
+				break
+			}
+
+			counter.count -= deleted
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpiredDocuments deletes the documents whose expiration time has been reached.
+// As with any other deletion, the history of the deleted documents is kept.
+func (e *Engine) DeleteExpiredDocuments(ctx context.Context) error {
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	var tables []*sql.Table
+	var stmts []sql.SQLStmt
+
+	now := time.Now()
+
+	for _, table := range sqlTx.Catalog().GetTables() {
+		col := ttlColumn(table)
+		if col == nil {
+			continue
+		}
+
+		tables = append(tables, table)
+
+		colSelector := sql.NewColSelector(table.Name(), col.Name())
+
+		stmts = append(stmts, sql.NewDeleteFromStmt(
+			table.Name(),
+			sql.NewBinBoolExp(
+				sql.And,
+				sql.NewCmpBoolExp(sql.NE, colSelector, sql.NewNull(sql.AnyType)),
+				sql.NewCmpBoolExp(sql.LE, colSelector, sql.NewInteger(now.Unix())),
+			),
+			nil,
+			nil,
+		))
+	}
+
+	sqlTx.Cancel()
+
+	for i, stmt := range stmts {
+		err := e.deleteDocuments(ctx, nil, tables[i], stmt)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
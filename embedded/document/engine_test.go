@@ -16,11 +16,15 @@ limitations under the License.
 package document
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -1052,6 +1056,64 @@ func TestQueryDocuments(t *testing.T) {
 		require.NoError(t, err)
 		require.EqualValues(t, 10, count)
 	})
+
+	t.Run("test query with OR across expressions", func(t *testing.T) {
+		// (pincode = 2) OR (country = 'country-2') OR (pincode >= 9 AND country != 'country-10')
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewNumberValue(2),
+						},
+					},
+				},
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "country",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("country-2"),
+						},
+					},
+				},
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_GE,
+							Value:    structpb.NewNumberValue(9),
+						},
+						{
+							Field:    "country",
+							Operator: protomodel.ComparisonOperator_NE,
+							Value:    structpb.NewStringValue("country-10"),
+						},
+					},
+				},
+			},
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 11)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 2)
+
+		// documents matching more than one expression are returned once
+		require.NotEqual(t, docs[0].DocumentId, docs[1].DocumentId)
+		require.Equal(t, "country-2", docs[0].Document.Fields["country"].GetStringValue())
+		require.Equal(t, "country-9", docs[1].Document.Fields["country"].GetStringValue())
+
+		count, err := engine.CountDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, count)
+	})
 }
 
 func TestDocumentUpdate(t *testing.T) {
@@ -1338,6 +1400,248 @@ func TestFloatSupport(t *testing.T) {
 	require.Equal(t, 3.1, doc.Document.Fields["number"].GetNumberValue())
 }
 
+func TestTextMatchingOperators(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		"admin",
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+			{Name: "age", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"name"}},
+		},
+	)
+	require.NoError(t, err)
+
+	names := []string{"Alice", "alfred", "Bob", "al_bert", "Malvina"}
+
+	docs := make([]*structpb.Struct, len(names))
+	for i, name := range names {
+		docs[i] = &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue(name),
+				"age":  structpb.NewNumberValue(float64(20 + i)),
+			},
+		}
+	}
+
+	_, _, err = engine.InsertDocuments(ctx, "admin", collectionName, docs)
+	require.NoError(t, err)
+
+	queryNames := func(t *testing.T, cmp *protomodel.FieldComparison) []string {
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{FieldComparisons: []*protomodel.FieldComparison{cmp}},
+			},
+		}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, len(names))
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		res := make([]string, len(revisions))
+		for i, rev := range revisions {
+			res[i] = rev.Document.Fields["name"].GetStringValue()
+		}
+		return res
+	}
+
+	t.Run("prefix", func(t *testing.T) {
+		res := queryNames(t, &protomodel.FieldComparison{
+			Field:    "name",
+			Operator: protomodel.ComparisonOperator_PREFIX,
+			Value:    structpb.NewStringValue("al"),
+		})
+		require.ElementsMatch(t, []string{"alfred", "al_bert"}, res)
+	})
+
+	t.Run("prefix with wildcard characters", func(t *testing.T) {
+		res := queryNames(t, &protomodel.FieldComparison{
+			Field:    "name",
+			Operator: protomodel.ComparisonOperator_PREFIX,
+			Value:    structpb.NewStringValue("al_"),
+		})
+		require.ElementsMatch(t, []string{"al_bert"}, res)
+	})
+
+	t.Run("case insensitive prefix", func(t *testing.T) {
+		res := queryNames(t, &protomodel.FieldComparison{
+			Field:           "name",
+			Operator:        protomodel.ComparisonOperator_PREFIX,
+			Value:           structpb.NewStringValue("AL"),
+			CaseInsensitive: true,
+		})
+		require.ElementsMatch(t, []string{"Alice", "alfred", "al_bert"}, res)
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		res := queryNames(t, &protomodel.FieldComparison{
+			Field:    "name",
+			Operator: protomodel.ComparisonOperator_CONTAINS,
+			Value:    structpb.NewStringValue("l"),
+		})
+		require.ElementsMatch(t, []string{"Alice", "alfred", "al_bert", "Malvina"}, res)
+	})
+
+	t.Run("case insensitive contains", func(t *testing.T) {
+		res := queryNames(t, &protomodel.FieldComparison{
+			Field:           "name",
+			Operator:        protomodel.ComparisonOperator_CONTAINS,
+			Value:           structpb.NewStringValue("B"),
+			CaseInsensitive: true,
+		})
+		require.ElementsMatch(t, []string{"Bob", "al_bert"}, res)
+	})
+
+	t.Run("case insensitive like", func(t *testing.T) {
+		res := queryNames(t, &protomodel.FieldComparison{
+			Field:           "name",
+			Operator:        protomodel.ComparisonOperator_LIKE,
+			Value:           structpb.NewStringValue("a%e"),
+			CaseInsensitive: true,
+		})
+		require.ElementsMatch(t, []string{"Alice"}, res)
+	})
+
+	t.Run("text matching on non string field", func(t *testing.T) {
+		_, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "age",
+							Operator: protomodel.ComparisonOperator_CONTAINS,
+							Value:    structpb.NewNumberValue(2),
+						},
+					},
+				},
+			},
+		}, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+		require.ErrorIs(t, err, ErrInvalidQueryOperator)
+	})
+
+	t.Run("case insensitive comparison", func(t *testing.T) {
+		_, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:           "name",
+							Operator:        protomodel.ComparisonOperator_EQ,
+							Value:           structpb.NewStringValue("alice"),
+							CaseInsensitive: true,
+						},
+					},
+				},
+			},
+		}, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+		require.ErrorIs(t, err, ErrInvalidQueryOperator)
+	})
+}
+
+func TestFullScan(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		"admin",
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+			{Name: "city", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"country"}},
+		},
+	)
+	require.NoError(t, err)
+
+	_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"country": structpb.NewStringValue("country-1"),
+			"city":    structpb.NewStringValue("city-1"),
+		},
+	})
+	require.NoError(t, err)
+
+	fullScan := func(cmps ...*protomodel.FieldComparison) bool {
+		query := &protomodel.Query{CollectionName: collectionName}
+		if len(cmps) > 0 {
+			query.Expressions = []*protomodel.QueryExpression{{FieldComparisons: cmps}}
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		_, explainedFullScan, err := engine.ExplainDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		require.Equal(t, reader.FullScan(), explainedFullScan)
+
+		return reader.FullScan()
+	}
+
+	prefix := func(field string, caseInsensitive bool) *protomodel.FieldComparison {
+		return &protomodel.FieldComparison{
+			Field:           field,
+			Operator:        protomodel.ComparisonOperator_PREFIX,
+			Value:           structpb.NewStringValue("c"),
+			CaseInsensitive: caseInsensitive,
+		}
+	}
+
+	require.False(t, fullScan())
+	require.False(t, fullScan(prefix("country", false)))
+	require.True(t, fullScan(prefix("country", true)))
+	require.True(t, fullScan(&protomodel.FieldComparison{Field: "country", Operator: protomodel.ComparisonOperator_CONTAINS, Value: structpb.NewStringValue("1")}))
+
+	t.Run("fields not covered by an index are fully scanned", func(t *testing.T) {
+		require.True(t, fullScan(prefix("city", false)))
+		require.True(t, fullScan(&protomodel.FieldComparison{Field: "city", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("city-1")}))
+	})
+
+	t.Run("an index range restricts the scan", func(t *testing.T) {
+		require.False(t, fullScan(
+			&protomodel.FieldComparison{Field: "country", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("country-1")},
+			&protomodel.FieldComparison{Field: "city", Operator: protomodel.ComparisonOperator_CONTAINS, Value: structpb.NewStringValue("1")},
+		))
+	})
+}
+
+func TestPrefixUpperBound(t *testing.T) {
+	upperBound, bounded := prefixUpperBound("ab")
+	require.True(t, bounded)
+	require.Equal(t, "ac", upperBound)
+
+	upperBound, bounded = prefixUpperBound("a\xff")
+	require.True(t, bounded)
+	require.Equal(t, "b", upperBound)
+
+	_, bounded = prefixUpperBound("\xff\xff")
+	require.False(t, bounded)
+
+	_, bounded = prefixUpperBound("")
+	require.False(t, bounded)
+}
+
 func TestDeleteCollection(t *testing.T) {
 	engine := makeEngine(t)
 
@@ -1785,6 +2089,16 @@ func TestCollectionUpdateWithDeletedIndex(t *testing.T) {
 		require.Len(t, collection.Indexes, 1)
 	})
 
+	t.Run("delete index on unindexed field should fail", func(t *testing.T) {
+		err := engine.DeleteIndex(
+			context.Background(),
+			"admin",
+			collectionName,
+			[]string{"number"},
+		)
+		require.ErrorIs(t, err, ErrFieldNotIndexed)
+	})
+
 	t.Run("update collection by adding the same index should pass", func(t *testing.T) {
 		// update collection
 		err := engine.CreateIndex(
@@ -1803,6 +2117,86 @@ func TestCollectionUpdateWithDeletedIndex(t *testing.T) {
 	})
 }
 
+func TestCreateIndexOnExistingDocuments(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(docPrefix))
+	require.NoError(t, err)
+
+	collectionName := "mycollection"
+
+	err = engine.CreateCollection(ctx, "admin", collectionName, "", []*protomodel.Field{
+		{Name: "city", Type: protomodel.FieldType_STRING},
+		{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+	}, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"city":    structpb.NewStringValue(fmt.Sprintf("city-%d", i%10)),
+				"pincode": structpb.NewNumberValue(float64(i)),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// the index is built over the documents already stored in the collection
+	err = engine.CreateIndex(ctx, "admin", collectionName, []string{"city"}, false)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.Len(t, collection.Indexes, 2)
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		Expressions: []*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "city", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("city-3")},
+				},
+			},
+		},
+		OrderBy: []*protomodel.OrderByClause{{Field: "city"}},
+	}
+
+	countDocs := func(engine *Engine) int64 {
+		count, err := engine.CountDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		return count
+	}
+
+	require.EqualValues(t, 10, countDocs(engine))
+
+	_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"city":    structpb.NewStringValue("city-3"),
+			"pincode": structpb.NewNumberValue(100),
+		},
+	})
+	require.NoError(t, err)
+
+	require.EqualValues(t, 11, countDocs(engine))
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	// indexing resumes from the last indexed transaction once the store is reopened
+	st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(docPrefix))
+	require.NoError(t, err)
+
+	require.EqualValues(t, 11, countDocs(engine))
+}
+
 func TestBulkInsert(t *testing.T) {
 	ctx := context.Background()
 	engine := makeEngine(t)
@@ -1927,24 +2321,350 @@ func TestPaginationOnReader(t *testing.T) {
 	})
 }
 
-func TestDeleteDocument(t *testing.T) {
+func TestGetDocumentsAtTx(t *testing.T) {
 	ctx := context.Background()
 	engine := makeEngine(t)
-	// create collection
+
 	collectionName := "mycollection"
-	err := engine.CreateCollection(context.Background(), "admin", collectionName, "", []*protomodel.Field{
-		{Name: "pincode", Type: protomodel.FieldType_INTEGER},
-		{Name: "country", Type: protomodel.FieldType_STRING},
-	}, nil)
-	require.NoError(t, err)
 
-	// add document to collection
-	_, _, err = engine.InsertDocument(context.Background(), "admin", collectionName, &structpb.Struct{
-		Fields: map[string]*structpb.Value{
-			"pincode": structpb.NewNumberValue(2),
-			"country": structpb.NewStringValue("wonderland"),
-		},
-	})
+	err := engine.CreateCollection(
+		ctx,
+		"admin",
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"pincode"}},
+		},
+	)
+	require.NoError(t, err)
+
+	var atTx uint64
+
+	for i := 1.0; i <= 10; i++ {
+		atTx, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"pincode": structpb.NewNumberValue(i),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	pincodeEq := func(pincode float64) *protomodel.Query {
+		return &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewNumberValue(pincode),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// changes made after atTx must not be visible when reading at atTx
+	_, err = engine.ReplaceDocuments(ctx, "admin", pincodeEq(1), &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"pincode": structpb.NewNumberValue(100),
+		},
+	})
+	require.NoError(t, err)
+
+	err = engine.DeleteDocuments(ctx, "admin", pincodeEq(2))
+	require.NoError(t, err)
+
+	_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"pincode": structpb.NewNumberValue(11),
+		},
+	})
+	require.NoError(t, err)
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		OrderBy:        []*protomodel.OrderByClause{{Field: "pincode"}},
+	}
+
+	readAll := func(reader DocumentReader) []float64 {
+		defer reader.Close()
+
+		var pincodes []float64
+
+		for {
+			doc, err := reader.Read(ctx)
+			if errors.Is(err, ErrNoMoreDocuments) {
+				return pincodes
+			}
+			require.NoError(t, err)
+
+			pincodes = append(pincodes, doc.Document.Fields["pincode"].GetNumberValue())
+		}
+	}
+
+	t.Run("latest documents", func(t *testing.T) {
+		reader, err := engine.GetDocumentsAtTx(ctx, query, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, []float64{3, 4, 5, 6, 7, 8, 9, 10, 11, 100}, readAll(reader))
+	})
+
+	t.Run("documents at tx", func(t *testing.T) {
+		reader, err := engine.GetDocumentsAtTx(ctx, query, atTx, 0)
+		require.NoError(t, err)
+		require.Equal(t, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, readAll(reader))
+
+		reader, err = engine.GetDocumentsAtTx(ctx, pincodeEq(1), atTx, 0)
+		require.NoError(t, err)
+		require.Equal(t, []float64{1}, readAll(reader))
+
+		reader, err = engine.GetDocumentsAtTx(ctx, query, atTx, 5)
+		require.NoError(t, err)
+		require.Equal(t, []float64{6, 7, 8, 9, 10}, readAll(reader))
+	})
+
+	t.Run("documents after cursor at tx", func(t *testing.T) {
+		reader, err := engine.GetDocumentsAfterAtTx(ctx, query, atTx, nil)
+		require.NoError(t, err)
+
+		docs, err := reader.ReadN(ctx, 5)
+		require.NoError(t, err)
+		reader.Close()
+
+		cursor, err := engine.NewCursor(query, docs[len(docs)-1])
+		require.NoError(t, err)
+
+		reader, err = engine.GetDocumentsAfterAtTx(ctx, query, atTx, cursor)
+		require.NoError(t, err)
+		require.Equal(t, []float64{6, 7, 8, 9, 10}, readAll(reader))
+	})
+}
+
+func TestCursorPagination(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		"admin",
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+			{Name: "age", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"age"}},
+		},
+	)
+	require.NoError(t, err)
+
+	ages := []int{30, 20, -1, 30, 10, 20, -1, 40, 30, 10, 20}
+
+	docs := make([]*structpb.Struct, len(ages))
+	for i, age := range ages {
+		docs[i] = &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue(fmt.Sprintf("name-%d", i)),
+			},
+		}
+		if age >= 0 {
+			docs[i].Fields["age"] = structpb.NewNumberValue(float64(age))
+		}
+	}
+
+	_, _, err = engine.InsertDocuments(ctx, "admin", collectionName, docs)
+	require.NoError(t, err)
+
+	readAll := func(t *testing.T, query *protomodel.Query, pageSize int) []*protomodel.DocumentAtRevision {
+		var results []*protomodel.DocumentAtRevision
+		var cursor *Cursor
+
+		for {
+			reader, err := engine.GetDocumentsAfter(ctx, query, cursor)
+			require.NoError(t, err)
+
+			page, err := reader.ReadN(ctx, pageSize)
+			reader.Close()
+
+			results = append(results, page...)
+
+			if errors.Is(err, ErrNoMoreDocuments) {
+				return results
+			}
+			require.NoError(t, err)
+
+			cursor, err = engine.NewCursor(query, page[len(page)-1])
+			require.NoError(t, err)
+
+			encodedCursor, err := cursor.Encode()
+			require.NoError(t, err)
+
+			cursor, err = DecodeCursor(encodedCursor)
+			require.NoError(t, err)
+		}
+	}
+
+	ageOf := func(rev *protomodel.DocumentAtRevision) int {
+		age, ok := rev.Document.Fields["age"]
+		if !ok {
+			return -1
+		}
+		return int(age.GetNumberValue())
+	}
+
+	for _, desc := range []bool{false, true} {
+		t.Run(fmt.Sprintf("order by age desc=%v", desc), func(t *testing.T) {
+			query := &protomodel.Query{
+				CollectionName: collectionName,
+				OrderBy:        []*protomodel.OrderByClause{{Field: "age", Desc: desc}},
+			}
+
+			results := readAll(t, query, 2)
+			require.Len(t, results, len(ages))
+
+			seen := make(map[string]struct{})
+
+			for i, rev := range results {
+				seen[rev.DocumentId] = struct{}{}
+
+				if i == 0 {
+					continue
+				}
+
+				prev := results[i-1]
+
+				if ageOf(prev) == ageOf(rev) {
+					if desc {
+						require.Greater(t, prev.DocumentId, rev.DocumentId)
+					} else {
+						require.Less(t, prev.DocumentId, rev.DocumentId)
+					}
+				} else if desc {
+					require.Greater(t, ageOf(prev), ageOf(rev))
+				} else {
+					require.Less(t, ageOf(prev), ageOf(rev))
+				}
+			}
+
+			require.Len(t, seen, len(ages))
+		})
+	}
+
+	t.Run("filtered query", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    "age",
+					Operator: protomodel.ComparisonOperator_GE,
+					Value:    structpb.NewNumberValue(20),
+				}},
+			}},
+		}
+
+		results := readAll(t, query, 3)
+		require.Len(t, results, 7)
+
+		for i, rev := range results {
+			require.GreaterOrEqual(t, ageOf(rev), 20)
+
+			if i > 0 {
+				require.Less(t, results[i-1].DocumentId, rev.DocumentId)
+			}
+		}
+	})
+
+	t.Run("documents inserted before the cursor are not read", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "age"}},
+		}
+
+		reader, err := engine.GetDocumentsAfter(ctx, query, nil)
+		require.NoError(t, err)
+
+		page, err := reader.ReadN(ctx, 5)
+		require.NoError(t, err)
+		reader.Close()
+
+		cursor, err := engine.NewCursor(query, page[len(page)-1])
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("youngest"),
+				"age":  structpb.NewNumberValue(1),
+			},
+		})
+		require.NoError(t, err)
+
+		reader, err = engine.GetDocumentsAfter(ctx, query, cursor)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		rest, err := reader.ReadN(ctx, len(ages))
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, rest, len(ages)-5)
+
+		for _, rev := range rest {
+			require.NotEqual(t, "youngest", rev.Document.Fields["name"].GetStringValue())
+		}
+	})
+
+	t.Run("invalid cursors", func(t *testing.T) {
+		_, err := DecodeCursor("not a cursor")
+		require.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, err = DecodeCursor("")
+		require.ErrorIs(t, err, ErrInvalidCursor)
+
+		cursor := &Cursor{DocumentID: NewDocumentIDFromTx(1)}
+
+		_, err = engine.GetDocumentsAfter(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "age"}},
+		}, cursor)
+		require.ErrorIs(t, err, ErrInvalidCursor)
+
+		cursor.Values = []*structpb.Value{structpb.NewStringValue("thirty")}
+
+		_, err = engine.GetDocumentsAfter(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "age"}},
+		}, cursor)
+		require.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, err = engine.GetDocumentsAfter(ctx, nil, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestDeleteDocument(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+	// create collection
+	collectionName := "mycollection"
+	err := engine.CreateCollection(context.Background(), "admin", collectionName, "", []*protomodel.Field{
+		{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		{Name: "country", Type: protomodel.FieldType_STRING},
+	}, nil)
+	require.NoError(t, err)
+
+	// add document to collection
+	_, _, err = engine.InsertDocument(context.Background(), "admin", collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"pincode": structpb.NewNumberValue(2),
+			"country": structpb.NewStringValue("wonderland"),
+		},
+	})
 	require.NoError(t, err)
 
 	query := &protomodel.Query{
@@ -1990,6 +2710,113 @@ func TestDeleteDocument(t *testing.T) {
 	require.ErrorIs(t, ErrNoMoreDocuments, err)
 }
 
+func TestDocumentExpiration(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	t.Run("invalid ttl settings", func(t *testing.T) {
+		err := engine.CreateCollectionWithTTL(ctx, "admin", "invalid", "", nil, nil, &protomodel.DocumentTTL{FieldName: "a.b"})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.CreateCollectionWithTTL(ctx, "admin", "invalid", "", nil, nil, &protomodel.DocumentTTL{FieldName: DocumentBLOBField})
+		require.ErrorIs(t, err, ErrReservedName)
+
+		err = engine.CreateCollectionWithTTL(ctx, "admin", "invalid", "", nil, nil, &protomodel.DocumentTTL{FieldName: DefaultDocumentIDField})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.CreateCollectionWithTTL(ctx, "admin", "invalid", "", nil, nil, &protomodel.DocumentTTL{DefaultSeconds: math.MaxInt32 + 1})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.CreateCollectionWithTTL(ctx, "admin", "invalid", "", []*protomodel.Field{
+			{Name: "expiration", Type: protomodel.FieldType_STRING},
+		}, nil, &protomodel.DocumentTTL{FieldName: "expiration"})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	collectionName := "sessions"
+
+	err := engine.CreateCollectionWithTTL(ctx, "admin", collectionName, "", []*protomodel.Field{
+		{Name: "user", Type: protomodel.FieldType_STRING},
+	}, nil, &protomodel.DocumentTTL{DefaultSeconds: 3600})
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.NotNil(t, collection.Ttl)
+	require.Equal(t, DefaultTTLField, collection.Ttl.FieldName)
+	require.Equal(t, uint64(3600), collection.Ttl.DefaultSeconds)
+
+	now := time.Now().Unix()
+
+	_, docIDs, err := engine.InsertDocuments(ctx, "admin", collectionName, []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{
+			"user": structpb.NewStringValue("alice"),
+		}},
+		{Fields: map[string]*structpb.Value{
+			"user":          structpb.NewStringValue("bob"),
+			DefaultTTLField: structpb.NewNumberValue(float64(now - 10)),
+		}},
+		{Fields: map[string]*structpb.Value{
+			"user":          structpb.NewStringValue("carol"),
+			DefaultTTLField: structpb.NewNullValue(),
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, docIDs, 3)
+
+	query := &protomodel.Query{CollectionName: collectionName}
+
+	reader, err := engine.GetDocuments(ctx, query, 0)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	revisions, err := reader.ReadN(ctx, 3)
+	require.ErrorIs(t, err, ErrNoMoreDocuments)
+	require.Len(t, revisions, 2)
+
+	require.Equal(t, "alice", revisions[0].Document.Fields["user"].GetStringValue())
+	require.GreaterOrEqual(t, int64(revisions[0].Document.Fields[DefaultTTLField].GetNumberValue()), now+3600)
+	require.Equal(t, "carol", revisions[1].Document.Fields["user"].GetStringValue())
+
+	count, err := engine.CountDocuments(ctx, query, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	err = engine.DeleteExpiredDocuments(ctx)
+	require.NoError(t, err)
+
+	count, err = engine.CountDocuments(ctx, query, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	// the history of expired documents is kept
+	require.Eventually(t, func() bool {
+		revs, err := engine.AuditDocument(ctx, collectionName, docIDs[1], false, 0, 10, true)
+		require.NoError(t, err)
+
+		return len(revs) == 2 && !revs[0].Metadata.GetDeleted() && revs[1].Metadata.GetDeleted()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// expired documents can't be replaced
+	_, err = engine.ReplaceDocuments(ctx, "admin", &protomodel.Query{
+		CollectionName: collectionName,
+		Expressions: []*protomodel.QueryExpression{{
+			FieldComparisons: []*protomodel.FieldComparison{{
+				Field:    DefaultDocumentIDField,
+				Operator: protomodel.ComparisonOperator_EQ,
+				Value:    structpb.NewStringValue(docIDs[1].EncodeToHexString()),
+			}},
+		}},
+	}, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"user": structpb.NewStringValue("bob"),
+	}})
+	require.NoError(t, err)
+
+	count, err = engine.CountDocuments(ctx, query, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
 func TestGetCollection(t *testing.T) {
 	engine := makeEngine(t)
 	collectionName := "mycollection1"
@@ -2167,3 +2994,818 @@ func BenchmarkInsertion(b *testing.B) {
 		wg.Wait()
 	}
 }
+
+func TestGetCollectionStats(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	_, err := engine.GetCollectionStats(ctx, "unknown")
+	require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+	collectionName := "products"
+
+	err = engine.CreateCollection(ctx, "admin", collectionName, "", []*protomodel.Field{
+		{Name: "category", Type: protomodel.FieldType_STRING},
+		{Name: "sku", Type: protomodel.FieldType_INTEGER},
+	}, []*protomodel.Index{
+		{Fields: []string{"category"}},
+		{Fields: []string{"sku"}, IsUnique: true},
+	})
+	require.NoError(t, err)
+
+	stats, err := engine.GetCollectionStats(ctx, collectionName)
+	require.NoError(t, err)
+	require.Equal(t, collectionName, stats.Name)
+	require.Zero(t, stats.DocumentCount)
+	require.Len(t, stats.Indexes, 3)
+
+	for _, index := range stats.Indexes {
+		require.Zero(t, index.Entries)
+		require.Zero(t, index.DistinctKeys)
+		require.Zero(t, index.Size)
+	}
+
+	for i, category := range []string{"book", "book", "music", "book"} {
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"category": structpb.NewStringValue(category),
+				"sku":      structpb.NewNumberValue(float64(i)),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	stats, err = engine.GetCollectionStats(ctx, collectionName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), stats.DocumentCount)
+
+	statsByFields := make(map[string]*protomodel.IndexStats, len(stats.Indexes))
+	for _, index := range stats.Indexes {
+		require.Equal(t, uint64(4), index.Entries)
+		require.Greater(t, index.Size, uint64(0))
+
+		statsByFields[strings.Join(index.Fields, ",")] = index
+	}
+
+	require.True(t, statsByFields[DefaultDocumentIDField].IsUnique)
+	require.Equal(t, uint64(4), statsByFields[DefaultDocumentIDField].DistinctKeys)
+	require.False(t, statsByFields["category"].IsUnique)
+	require.Equal(t, uint64(2), statsByFields["category"].DistinctKeys)
+	require.True(t, statsByFields["sku"].IsUnique)
+	require.Equal(t, uint64(4), statsByFields["sku"].DistinctKeys)
+}
+
+func TestStrictSchema(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "customers"
+
+	err := engine.CreateCollectionWithOptions(ctx, "admin", collectionName, "", []*protomodel.Field{
+		{Name: "name", Type: protomodel.FieldType_STRING},
+		{Name: "age", Type: protomodel.FieldType_INTEGER},
+		{Name: "address.city", Type: protomodel.FieldType_STRING},
+	}, nil, &CollectionOptions{StrictSchema: true})
+	require.NoError(t, err)
+
+	err = engine.CreateCollection(ctx, "admin", "schemaless", "", []*protomodel.Field{
+		{Name: "name", Type: protomodel.FieldType_STRING},
+	}, nil)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.True(t, collection.StrictSchema)
+
+	collection, err = engine.GetCollection(ctx, "schemaless")
+	require.NoError(t, err)
+	require.False(t, collection.StrictSchema)
+
+	t.Run("documents with declared fields should be accepted", func(t *testing.T) {
+		_, _, err := engine.InsertDocuments(ctx, "admin", collectionName, []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("alice"),
+				"age":  structpb.NewNumberValue(30),
+				"address": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"city": structpb.NewStringValue("Berlin"),
+				}}),
+			}},
+			{Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("bob"),
+				"age":  structpb.NewNullValue(),
+			}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("documents with undeclared fields should be rejected", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("carol"),
+				"agee": structpb.NewNumberValue(30),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+		require.ErrorContains(t, err, "field 'agee' is not declared")
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"address": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"city":    structpb.NewStringValue("Berlin"),
+					"country": structpb.NewStringValue("Germany"),
+				}}),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+		require.ErrorContains(t, err, "field 'address.country' is not declared")
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"first": structpb.NewStringValue("carol"),
+				}}),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+		require.ErrorContains(t, err, "field 'name'")
+	})
+
+	t.Run("documents with type-mismatched values should be rejected", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"age": structpb.NewStringValue("thirty"),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+		require.ErrorContains(t, err, "field 'age'")
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"age": structpb.NewNumberValue(30.5),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+		require.ErrorContains(t, err, "field 'age'")
+	})
+
+	t.Run("replaced documents should be validated", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "name", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("bob")},
+				},
+			}},
+		}
+
+		_, err := engine.ReplaceDocuments(ctx, "admin", query, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name":     structpb.NewStringValue("bob"),
+				"nickname": structpb.NewStringValue("bobby"),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+
+		_, err = engine.ReplaceDocuments(ctx, "admin", query, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("bob"),
+				"age":  structpb.NewNumberValue(40),
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("added fields should be accepted", func(t *testing.T) {
+		err := engine.AddField(ctx, "admin", collectionName, &protomodel.Field{Name: "nickname", Type: protomodel.FieldType_STRING})
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name":     structpb.NewStringValue("carol"),
+				"nickname": structpb.NewStringValue("caz"),
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("schemaless collections should accept any field", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "admin", "schemaless", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("dave"),
+				"agee": structpb.NewNumberValue(30),
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("strict schema should be kept after reopening", func(t *testing.T) {
+		reopened, err := NewEngine(engine.sqlEngine.GetStore(), DefaultOptions().WithPrefix(docPrefix))
+		require.NoError(t, err)
+
+		collection, err := reopened.GetCollection(ctx, collectionName)
+		require.NoError(t, err)
+		require.True(t, collection.StrictSchema)
+
+		_, _, err = reopened.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"agee": structpb.NewNumberValue(30),
+			},
+		})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+	})
+}
+
+func TestInsertDuplicatedDocument(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	err := engine.CreateCollection(ctx, "admin", "customers", "", []*protomodel.Field{
+		{Name: "email", Type: protomodel.FieldType_STRING},
+	}, []*protomodel.Index{
+		{Fields: []string{"email"}, IsUnique: true},
+	})
+	require.NoError(t, err)
+
+	_, _, err = engine.InsertDocument(ctx, "admin", "customers", &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"email": structpb.NewStringValue("customer1@example.com"),
+		},
+	})
+	require.NoError(t, err)
+
+	// insertions don't wait for a snapshot of the index including the latest documents,
+	// the stored document is found once such a snapshot was taken by a query using the index
+	count, err := engine.CountDocuments(ctx, &protomodel.Query{
+		CollectionName: "customers",
+		Expressions: []*protomodel.QueryExpression{{
+			FieldComparisons: []*protomodel.FieldComparison{
+				{Field: "email", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("customer1@example.com")},
+			},
+		}},
+	}, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
+
+	_, _, err = engine.InsertDocument(ctx, "admin", "customers", &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"email": structpb.NewStringValue("customer1@example.com"),
+		},
+	})
+	require.ErrorIs(t, err, ErrDocumentAlreadyExists)
+	require.ErrorIs(t, err, ErrConflict)
+}
+
+func TestCollations(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	fields := []*protomodel.Field{
+		{Name: "username", Type: protomodel.FieldType_STRING},
+		{Name: "surname", Type: protomodel.FieldType_STRING},
+		{Name: "age", Type: protomodel.FieldType_INTEGER},
+	}
+
+	t.Run("collection creation should fail with invalid collations", func(t *testing.T) {
+		err := engine.CreateCollection(ctx, "admin", "users", "", fields, []*protomodel.Index{
+			{Fields: []string{"username"}, Collation: "not a collation"},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.CreateCollection(ctx, "admin", "users", "", fields, []*protomodel.Index{
+			{Fields: []string{"username"}, Collation: NoCaseCollation},
+			{Fields: []string{"username", "age"}},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.CreateCollection(ctx, "admin", "users", "", fields, []*protomodel.Index{
+			{Fields: []string{"age"}, Collation: NoCaseCollation},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	err := engine.CreateCollection(ctx, "admin", "users", "", fields, []*protomodel.Index{
+		{Fields: []string{"username"}, IsUnique: true, Collation: "nocase"},
+		{Fields: []string{"surname"}, Collation: "de"},
+		{Fields: []string{"age"}},
+	})
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, "users")
+	require.NoError(t, err)
+	require.Len(t, collection.Indexes, 4)
+	require.Equal(t, NoCaseCollation, collection.Indexes[1].Collation)
+	require.Equal(t, "de", collection.Indexes[2].Collation)
+	require.Empty(t, collection.Indexes[3].Collation)
+
+	users := []struct {
+		username string
+		surname  string
+	}{
+		{"Alice", "Zimmermann"},
+		{"bob", "Ärger"},
+		{"Carol", "Adler"},
+		{"dave", "Becker"},
+	}
+
+	for i, u := range users {
+		_, _, err = engine.InsertDocument(ctx, "admin", "users", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"username": structpb.NewStringValue(u.username),
+				"surname":  structpb.NewStringValue(u.surname),
+				"age":      structpb.NewNumberValue(float64(30 + i)),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("unique index should reject values only differing in case", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "admin", "users", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"username": structpb.NewStringValue("ALICE"),
+			},
+		})
+		require.ErrorIs(t, err, ErrConflict)
+	})
+
+	query := func(t *testing.T, field string, orderBy []*protomodel.OrderByClause, cmps ...*protomodel.FieldComparison) []string {
+		q := &protomodel.Query{
+			CollectionName: "users",
+			OrderBy:        orderBy,
+		}
+
+		if len(cmps) > 0 {
+			q.Expressions = []*protomodel.QueryExpression{{FieldComparisons: cmps}}
+		}
+
+		reader, err := engine.GetDocuments(ctx, q, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, len(users)+1)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		res := make([]string, len(revisions))
+		for i, rev := range revisions {
+			res[i] = rev.Document.Fields[field].GetStringValue()
+		}
+		return res
+	}
+
+	t.Run("documents should be returned as stored", func(t *testing.T) {
+		res := query(t, "username", []*protomodel.OrderByClause{{Field: "username"}})
+		require.Equal(t, []string{"Alice", "bob", "Carol", "dave"}, res)
+	})
+
+	t.Run("query values should be normalized using the collation of the field", func(t *testing.T) {
+		res := query(t, "username", nil, &protomodel.FieldComparison{
+			Field:    "username",
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue("BOB"),
+		})
+		require.Equal(t, []string{"bob"}, res)
+
+		res = query(t, "username", nil, &protomodel.FieldComparison{
+			Field:     "username",
+			Operator:  protomodel.ComparisonOperator_PREFIX,
+			Value:     structpb.NewStringValue("CA"),
+			Collation: NoCaseCollation,
+		})
+		require.Equal(t, []string{"Carol"}, res)
+
+		res = query(t, "username", []*protomodel.OrderByClause{{Field: "username"}}, &protomodel.FieldComparison{
+			Field:    "username",
+			Operator: protomodel.ComparisonOperator_GE,
+			Value:    structpb.NewStringValue("B"),
+		}, &protomodel.FieldComparison{
+			Field:    "username",
+			Operator: protomodel.ComparisonOperator_LT,
+			Value:    structpb.NewStringValue("D"),
+		})
+		require.Equal(t, []string{"bob", "Carol"}, res)
+
+		res = query(t, "username", nil, &protomodel.FieldComparison{
+			Field:    "username",
+			Operator: protomodel.ComparisonOperator_LIKE,
+			Value:    structpb.NewStringValue("DA%"),
+		})
+		require.Equal(t, []string{"dave"}, res)
+	})
+
+	t.Run("locale-aware collations should sort following the language", func(t *testing.T) {
+		res := query(t, "surname", []*protomodel.OrderByClause{{Field: "surname"}})
+		require.Equal(t, []string{"Adler", "Ärger", "Becker", "Zimmermann"}, res)
+
+		res = query(t, "surname", nil, &protomodel.FieldComparison{
+			Field:    "surname",
+			Operator: protomodel.ComparisonOperator_LT,
+			Value:    structpb.NewStringValue("B"),
+		})
+		require.ElementsMatch(t, []string{"Adler", "Ärger"}, res)
+
+		res = query(t, "surname", nil, &protomodel.FieldComparison{
+			Field:    "surname",
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue("Ärger"),
+		})
+		require.Equal(t, []string{"Ärger"}, res)
+	})
+
+	t.Run("long values should fit the length of fields using a locale-aware collation", func(t *testing.T) {
+		// collation keys take about five times the bytes of the values
+		surname := "Gesellschaft für Datenverarbeitung und Informationssysteme mbH, Abteilung Qualitätssicherung"
+
+		_, docID, err := engine.InsertDocument(ctx, "admin", "users", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"username": structpb.NewStringValue("erin"),
+				"surname":  structpb.NewStringValue(surname),
+			},
+		})
+		require.NoError(t, err)
+
+		res := query(t, "surname", nil, &protomodel.FieldComparison{
+			Field:    "surname",
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue(surname),
+		})
+		require.Equal(t, []string{surname}, res)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", "users", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"username": structpb.NewStringValue("frank"),
+				"surname":  structpb.NewStringValue(surname + " und Prüfung, Standort Nürnberg"),
+			},
+		})
+		require.ErrorIs(t, err, ErrMaxLengthExceeded)
+		require.ErrorContains(t, err, "collation 'de'")
+		require.ErrorContains(t, err, "surname")
+
+		err = engine.DeleteDocuments(ctx, "admin", &protomodel.Query{
+			CollectionName: "users",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "_id", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue(docID.EncodeToHexString())},
+				},
+			}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("mixing collations should be rejected", func(t *testing.T) {
+		_, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: "users",
+			Expressions: []*protomodel.QueryExpression{{FieldComparisons: []*protomodel.FieldComparison{{
+				Field:     "username",
+				Operator:  protomodel.ComparisonOperator_EQ,
+				Value:     structpb.NewStringValue("bob"),
+				Collation: BinaryCollation,
+			}}}},
+		}, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: "users",
+			Expressions: []*protomodel.QueryExpression{{FieldComparisons: []*protomodel.FieldComparison{{
+				Field:    "surname",
+				Operator: protomodel.ComparisonOperator_PREFIX,
+				Value:    structpb.NewStringValue("A"),
+			}}}},
+		}, 0)
+		require.ErrorIs(t, err, ErrInvalidQueryOperator)
+
+		err = engine.CreateIndexWithCollation(ctx, "admin", "users", []string{"username", "age"}, false, "")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.CreateIndexWithCollation(ctx, "admin", "users", []string{"username", "age"}, false, NoCaseCollation)
+		require.NoError(t, err)
+	})
+
+	t.Run("cursor pagination should follow the collation", func(t *testing.T) {
+		q := &protomodel.Query{
+			CollectionName: "users",
+			OrderBy:        []*protomodel.OrderByClause{{Field: "username"}},
+		}
+
+		var res []string
+		var cursor *Cursor
+
+		for {
+			reader, err := engine.GetDocumentsAfter(ctx, q, cursor)
+			require.NoError(t, err)
+
+			revisions, err := reader.ReadN(ctx, 1)
+			reader.Close()
+			if errors.Is(err, ErrNoMoreDocuments) {
+				break
+			}
+			require.NoError(t, err)
+
+			res = append(res, revisions[0].Document.Fields["username"].GetStringValue())
+
+			cursor, err = engine.NewCursor(q, revisions[0])
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, []string{"Alice", "bob", "Carol", "dave"}, res)
+	})
+}
+
+func TestExplainDocuments(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		"admin",
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"country"}},
+			{Fields: []string{"pincode"}, IsUnique: true},
+		},
+	)
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"pincode": structpb.NewNumberValue(float64(i)),
+				"country": structpb.NewStringValue(fmt.Sprintf("country-%d", i%2)),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	_, _, err = engine.ExplainDocuments(ctx, nil, 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = engine.ExplainDocuments(ctx, &protomodel.Query{CollectionName: "unknown"}, 0)
+	require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+	t.Run("full scan", func(t *testing.T) {
+		plan, fullScan, err := engine.ExplainDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "country", Operator: protomodel.ComparisonOperator_CONTAINS, Value: structpb.NewStringValue("1")},
+				},
+			}},
+		}, 0)
+		require.NoError(t, err)
+		require.True(t, fullScan)
+
+		require.Equal(t, []string{DefaultDocumentIDField}, plan.Index.Fields)
+		require.Empty(t, plan.Ranges)
+		require.Equal(t, uint64(10), plan.EstimatedDocuments)
+		require.Equal(t, uint64(10), plan.ScannedDocuments)
+		require.Equal(t, uint64(5), plan.MatchedDocuments)
+	})
+
+	t.Run("index scan", func(t *testing.T) {
+		plan, fullScan, err := engine.ExplainDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_GT, Value: structpb.NewNumberValue(3)},
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_LE, Value: structpb.NewNumberValue(8)},
+				},
+			}},
+			OrderBy: []*protomodel.OrderByClause{{Field: "pincode", Desc: true}},
+		}, 0)
+		require.NoError(t, err)
+		require.False(t, fullScan)
+
+		require.Equal(t, []string{"pincode"}, plan.Index.Fields)
+		require.True(t, plan.Index.IsUnique)
+		require.True(t, plan.Desc)
+		require.False(t, plan.SortRequired)
+
+		require.Len(t, plan.Ranges, 1)
+		require.Equal(t, "pincode", plan.Ranges[0].Field)
+		require.Equal(t, 3.0, plan.Ranges[0].LowerBound.GetNumberValue())
+		require.False(t, plan.Ranges[0].LowerInclusive)
+		require.Equal(t, 8.0, plan.Ranges[0].UpperBound.GetNumberValue())
+		require.True(t, plan.Ranges[0].UpperInclusive)
+
+		require.Equal(t, uint64(10), plan.EstimatedDocuments)
+		// the lower bound is exclusive, so the entry with pincode 3 is scanned but discarded
+		require.Equal(t, uint64(6), plan.ScannedDocuments)
+		require.Equal(t, uint64(5), plan.MatchedDocuments)
+	})
+
+	t.Run("point lookup", func(t *testing.T) {
+		plan, fullScan, err := engine.ExplainDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "country", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("country-0")},
+				},
+			}},
+		}, 0)
+		require.NoError(t, err)
+		require.False(t, fullScan)
+
+		require.Equal(t, []string{"country"}, plan.Index.Fields)
+		require.False(t, plan.Index.IsUnique)
+
+		require.Len(t, plan.Ranges, 1)
+		require.Equal(t, "country-0", plan.Ranges[0].LowerBound.GetStringValue())
+		require.Equal(t, "country-0", plan.Ranges[0].UpperBound.GetStringValue())
+
+		// 10 entries over 2 distinct countries
+		require.Equal(t, uint64(5), plan.EstimatedDocuments)
+		require.Equal(t, uint64(5), plan.ScannedDocuments)
+		require.Equal(t, uint64(5), plan.MatchedDocuments)
+	})
+
+	t.Run("sorting not covered by an index", func(t *testing.T) {
+		plan, fullScan, err := engine.ExplainDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy: []*protomodel.OrderByClause{
+				{Field: "country"},
+				{Field: "pincode"},
+			},
+			Limit: 3,
+		}, 0)
+		require.NoError(t, err)
+		require.False(t, fullScan)
+
+		require.True(t, plan.SortRequired)
+		require.Equal(t, uint64(10), plan.ScannedDocuments)
+		require.Equal(t, uint64(3), plan.MatchedDocuments)
+	})
+}
+
+func TestExportImportCollection(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	err := engine.CreateCollectionWithOptions(
+		ctx,
+		"admin",
+		"customers",
+		"customer_id",
+		[]*protomodel.Field{
+			{Name: "email", Type: protomodel.FieldType_STRING},
+			{Name: "age", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"email"}, IsUnique: true},
+			{Fields: []string{"age"}},
+		},
+		&CollectionOptions{StrictSchema: true},
+	)
+	require.NoError(t, err)
+
+	var docIDs []DocumentID
+
+	for i := 0; i < 5; i++ {
+		_, docID, err := engine.InsertDocument(ctx, "admin", "customers", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"email": structpb.NewStringValue(fmt.Sprintf("customer%d@example.com", i)),
+				"age":   structpb.NewNumberValue(float64(20 + i)),
+			},
+		})
+		require.NoError(t, err)
+
+		docIDs = append(docIDs, docID)
+	}
+
+	_, err = engine.ExportCollection(ctx, "unknown", &bytes.Buffer{})
+	require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+	var exported bytes.Buffer
+
+	txID, err := engine.ExportCollection(ctx, "customers", &exported)
+	require.NoError(t, err)
+	require.NotZero(t, txID)
+	require.Equal(t, 6, strings.Count(exported.String(), "\n"))
+
+	readIDs := func(t *testing.T, collectionName string) []string {
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		var ids []string
+
+		for {
+			rev, err := reader.Read(ctx)
+			if errors.Is(err, ErrNoMoreDocuments) {
+				return ids
+			}
+			require.NoError(t, err)
+
+			ids = append(ids, rev.DocumentId)
+		}
+	}
+
+	expectedIDs := make([]string, len(docIDs))
+	for i, docID := range docIDs {
+		expectedIDs[i] = docID.EncodeToHexString()
+	}
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := engine.ImportCollection(ctx, "admin", "restored", nil, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.ImportCollection(ctx, "admin", "restored", bytes.NewReader(exported.Bytes()), &ImportOptions{BatchSize: -1})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.ImportCollection(ctx, "admin", "restored", strings.NewReader(""), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.ImportCollection(ctx, "admin", "restored", strings.NewReader("not json\n"), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.ImportCollection(ctx, "admin", "customers", bytes.NewReader([]byte(`{"documentIdFieldName":"_id"}`)), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("import into a new collection", func(t *testing.T) {
+		var reported []ImportProgress
+
+		progress, err := engine.ImportCollection(ctx, "admin", "restored", bytes.NewReader(exported.Bytes()), &ImportOptions{
+			BatchSize: 2,
+			Progress:  func(p *ImportProgress) { reported = append(reported, *p) },
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), progress.Checkpoint)
+		require.Equal(t, uint64(5), progress.Imported)
+		require.Zero(t, progress.Skipped)
+
+		require.Len(t, reported, 3)
+		require.Equal(t, uint64(2), reported[0].Checkpoint)
+		require.Equal(t, uint64(4), reported[1].Checkpoint)
+		require.Equal(t, uint64(5), reported[2].Checkpoint)
+		require.Equal(t, progress.TxID, reported[2].TxID)
+
+		require.Equal(t, expectedIDs, readIDs(t, "restored"))
+
+		original, err := engine.GetCollection(ctx, "customers")
+		require.NoError(t, err)
+
+		restored, err := engine.GetCollection(ctx, "restored")
+		require.NoError(t, err)
+		require.Equal(t, "customer_id", restored.DocumentIdFieldName)
+		require.True(t, restored.StrictSchema)
+		require.Equal(t, original.Fields, restored.Fields)
+		require.Equal(t, original.Indexes, restored.Indexes)
+
+		// indexes are rebuilt
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: "restored",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "email", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("customer3@example.com")},
+				},
+			}},
+		}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		rev, err := reader.Read(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expectedIDs[3], rev.DocumentId)
+	})
+
+	t.Run("conflict policies", func(t *testing.T) {
+		_, err := engine.ImportCollection(ctx, "admin", "restored", bytes.NewReader(exported.Bytes()), nil)
+		require.ErrorIs(t, err, ErrConflict)
+
+		progress, err := engine.ImportCollection(ctx, "admin", "restored", bytes.NewReader(exported.Bytes()), &ImportOptions{
+			ConflictPolicy: ImportConflictSkip,
+		})
+		require.NoError(t, err)
+		require.Zero(t, progress.Imported)
+		require.Equal(t, uint64(5), progress.Skipped)
+		require.Zero(t, progress.TxID)
+
+		progress, err = engine.ImportCollection(ctx, "admin", "restored", bytes.NewReader(exported.Bytes()), &ImportOptions{
+			ConflictPolicy: ImportConflictOverwrite,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), progress.Imported)
+
+		require.Equal(t, expectedIDs, readIDs(t, "restored"))
+
+		revisions, err := engine.AuditDocument(ctx, "restored", docIDs[0], false, 0, 10, false)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+	})
+
+	t.Run("resume from a checkpoint", func(t *testing.T) {
+		progress, err := engine.ImportCollection(ctx, "admin", "resumed", bytes.NewReader(exported.Bytes()), &ImportOptions{
+			Checkpoint: 3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), progress.Checkpoint)
+		require.Equal(t, uint64(2), progress.Imported)
+
+		require.Equal(t, expectedIDs[3:], readIDs(t, "resumed"))
+	})
+}
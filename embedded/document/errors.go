@@ -17,6 +17,7 @@ package document
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -37,6 +38,12 @@ var (
 	ErrReservedName            = errors.New("reserved name")
 	ErrLimitedIndexCreation    = errors.New("unique index creation is only supported on empty collections")
 	ErrConflict                = errors.New("conflict due to uniqueness contraint violation or read document was updated by another transaction")
+	ErrInvalidCursor           = errors.New("invalid cursor")
+	ErrSchemaViolation         = errors.New("document does not match the collection schema")
+	ErrFieldNotIndexed         = errors.New("field is not indexed")
+	ErrDocumentQuotaExceeded   = errors.New("maximum number of documents exceeded")
+	ErrDocumentAlreadyExists   = fmt.Errorf("%w: document already exists", ErrConflict)
+	ErrInvalidQueryOperator    = fmt.Errorf("%w: invalid query operator", ErrIllegalArguments)
 )
 
 func mayTranslateError(err error) error {
@@ -64,6 +71,10 @@ func mayTranslateError(err error) error {
 		return ErrFieldDoesNotExist
 	}
 
+	if errors.Is(err, sql.ErrIndexNotFound) || errors.Is(err, sql.ErrColumnNotIndexed) {
+		return ErrFieldNotIndexed
+	}
+
 	if errors.Is(err, sql.ErrLimitedIndexCreation) {
 		return ErrLimitedIndexCreation
 	}
@@ -37,6 +37,8 @@ func TestMayTranslateError(t *testing.T) {
 		{sql.ErrColumnAlreadyExists, ErrFieldAlreadyExists},
 		{sql.ErrColumnDoesNotExist, ErrFieldDoesNotExist},
 		{sql.ErrLimitedIndexCreation, ErrLimitedIndexCreation},
+		{sql.ErrIndexNotFound, ErrFieldNotIndexed},
+		{sql.ErrColumnNotIndexed, ErrFieldNotIndexed},
 		{store.ErrTxReadConflict, ErrConflict},
 		{store.ErrKeyAlreadyExists, ErrConflict},
 		{errCustom, errCustom},
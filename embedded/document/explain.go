@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+)
+
+// ExplainDocuments runs the query the same way as GetDocumentsAtTx does and returns
+// the plan followed to resolve it instead of the matching documents.
+// All the matching documents are read, so the number of scanned documents can be reported.
+// Whether the query requires a full scan is reported as done by the readers returned by GetDocumentsAtTx.
+func (e *Engine) ExplainDocuments(ctx context.Context, query *protomodel.Query, atTx uint64) (*protomodel.QueryPlan, bool, error) {
+	sqlTx, op, err := e.documentsQueryStmt(ctx, query, atTx, 0, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer sqlTx.Cancel()
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer r.Close()
+
+	var matchedDocuments uint64
+
+	for {
+		_, err := r.Read(ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, false, mayTranslateError(err)
+		}
+
+		matchedDocuments++
+	}
+
+	scanSpecs := r.ScanSpecs()
+
+	estimatedDocuments, err := sqlTx.EstimateRows(ctx, scanSpecs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	fields := make([]string, len(scanSpecs.Index.Cols()))
+	for i, col := range scanSpecs.Index.Cols() {
+		fields[i] = col.Name()
+	}
+
+	plan := &protomodel.QueryPlan{
+		Index: &protomodel.Index{
+			Fields:    fields,
+			IsUnique:  scanSpecs.Index.IsUnique(),
+			Collation: indexCollation(scanSpecs.Index),
+		},
+		Desc:               scanSpecs.DescOrder,
+		SortRequired:       scanSpecs.SortRequired(),
+		EstimatedDocuments: estimatedDocuments,
+		ScannedDocuments:   scanSpecs.RowsScanned(),
+		MatchedDocuments:   matchedDocuments,
+	}
+
+	for _, indexRange := range scanSpecs.IndexRanges() {
+		if isLocaleCollation(columnCollation(indexRange.Column)) {
+			// bounds are collation keys, which can not be reported as field values
+			continue
+		}
+
+		fieldRange := &protomodel.FieldRange{
+			Field:          indexRange.Column.Name(),
+			LowerInclusive: indexRange.LowerInclusive,
+			UpperInclusive: indexRange.UpperInclusive,
+		}
+
+		if indexRange.Lower != nil {
+			fieldRange.LowerBound, err = sqlValueToStructValue(indexRange.Lower)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		if indexRange.Upper != nil {
+			fieldRange.UpperBound, err = sqlValueToStructValue(indexRange.Upper)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		plan.Ranges = append(plan.Ranges, fieldRange)
+	}
+
+	return plan, isFullScan(query, scanSpecs), nil
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// DefaultImportBatchSize is the default number of documents stored in each transaction during an import
+const DefaultImportBatchSize = 1000
+
+// ImportConflictPolicy defines how documents whose id is already used in the collection are imported
+type ImportConflictPolicy int
+
+const (
+	// ImportConflictFail stops the import with ErrDocumentAlreadyExists
+	ImportConflictFail ImportConflictPolicy = iota
+	// ImportConflictSkip keeps the stored document and discards the imported one
+	ImportConflictSkip
+	// ImportConflictOverwrite stores the imported document as a new revision of the stored one
+	ImportConflictOverwrite
+)
+
+// ImportOptions holds the settings of a collection import
+type ImportOptions struct {
+	// BatchSize is the number of documents stored in each transaction
+	BatchSize int
+	// ConflictPolicy defines how documents with an id already used in the collection are imported
+	ConflictPolicy ImportConflictPolicy
+	// Checkpoint is the number of documents of the stream to be skipped, it's used to resume
+	// an interrupted import from the checkpoint reported along with the progress
+	Checkpoint uint64
+	// Progress, if set, is called once each batch of documents has been committed
+	Progress func(progress *ImportProgress)
+}
+
+// ImportProgress describes the documents processed by an import
+type ImportProgress struct {
+	// Checkpoint is the number of documents of the stream processed so far, including the ones
+	// processed before resuming the import. Documents up to the checkpoint are already committed
+	Checkpoint uint64
+	// Imported is the number of documents stored in the collection
+	Imported uint64
+	// Skipped is the number of documents discarded because of a conflicting id
+	Skipped uint64
+	// TxID is the transaction the last batch of documents was committed in
+	TxID uint64
+}
+
+// ExportCollection writes the definition and the documents of the collection into w.
+// The stream consists of newline-delimited JSON values: the collection definition
+// followed by one document per line, sorted by document id. Expired documents are not exported.
+// Documents are read at the latest committed transaction, whose id is returned.
+func (e *Engine) ExportCollection(ctx context.Context, collectionName string, w io.Writer) (txID uint64, err error) {
+	collection, err := e.GetCollection(ctx, collectionName)
+	if err != nil {
+		return 0, err
+	}
+
+	txID = e.sqlEngine.GetStore().LastCommittedTxID()
+
+	reader, err := e.GetDocumentsAtTx(ctx, &protomodel.Query{CollectionName: collectionName}, txID, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	bw := bufio.NewWriter(w)
+
+	err = writeJSONLine(bw, collection)
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		rev, err := reader.Read(ctx)
+		if errors.Is(err, ErrNoMoreDocuments) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		err = writeJSONLine(bw, rev.Document)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return txID, bw.Flush()
+}
+
+func writeJSONLine(w *bufio.Writer, m proto.Message) error {
+	bs, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(bs, '\n'))
+	return err
+}
+
+// ImportCollection stores the documents of a stream written by ExportCollection into the collection,
+// which is created using the exported definition if it does not exist. Document ids are preserved,
+// documents whose id is already used in the collection are handled according to the conflict policy.
+//
+// Documents are committed in batches, the progress reported after each batch includes the checkpoint
+// from which the import can be resumed if it's interrupted.
+func (e *Engine) ImportCollection(ctx context.Context, username, collectionName string, r io.Reader, opts *ImportOptions) (*ImportProgress, error) {
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if opts == nil {
+		opts = &ImportOptions{}
+	}
+
+	if opts.BatchSize < 0 {
+		return nil, fmt.Errorf("%w: invalid batch size", ErrIllegalArguments)
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize == 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	br := bufio.NewReader(r)
+
+	var exported protomodel.Collection
+
+	err := readJSONLine(br, &exported)
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: missing collection definition", ErrIllegalArguments)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.createImportedCollection(ctx, username, collectionName, &exported)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &ImportProgress{Checkpoint: opts.Checkpoint}
+
+	var read uint64
+	var batch []*structpb.Struct
+
+	for {
+		doc := &structpb.Struct{}
+
+		err := readJSONLine(br, doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return progress, err
+		}
+
+		read++
+
+		if read <= opts.Checkpoint {
+			continue
+		}
+
+		batch = append(batch, doc)
+
+		if len(batch) == batchSize {
+			err = e.importDocuments(ctx, username, collectionName, batch, opts, progress)
+			if err != nil {
+				return progress, err
+			}
+
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		err = e.importDocuments(ctx, username, collectionName, batch, opts, progress)
+		if err != nil {
+			return progress, err
+		}
+	}
+
+	return progress, nil
+}
+
+// createImportedCollection creates the collection using the exported definition,
+// an existing collection is used as long as it has the same document id field
+func (e *Engine) createImportedCollection(ctx context.Context, username, collectionName string, exported *protomodel.Collection) error {
+	collection, err := e.GetCollection(ctx, collectionName)
+	if err == nil {
+		if collection.DocumentIdFieldName != exported.DocumentIdFieldName {
+			return fmt.Errorf("%w: collection '%s' uses a different document id field", ErrIllegalArguments, collectionName)
+		}
+		return nil
+	}
+	if !errors.Is(err, ErrCollectionDoesNotExist) {
+		return err
+	}
+
+	fields := make([]*protomodel.Field, 0, len(exported.Fields))
+
+	for _, field := range exported.Fields {
+		if field.Name == exported.DocumentIdFieldName {
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	return e.CreateCollectionWithOptions(
+		ctx,
+		username,
+		collectionName,
+		exported.DocumentIdFieldName,
+		fields,
+		exported.Indexes,
+		&CollectionOptions{
+			TTL:          exported.Ttl,
+			StrictSchema: exported.StrictSchema,
+			MaxDocuments: exported.MaxDocuments,
+		},
+	)
+}
+
+// importDocuments stores a batch of documents within a single transaction and updates the progress
+func (e *Engine) importDocuments(ctx context.Context, username, collectionName string, docs []*structpb.Struct, opts *ImportOptions, progress *ImportProgress) error {
+	txOpts := sql.DefaultTxOptions().
+		WithUnsafeMVCC(true).
+		WithExtra([]byte(username)).
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
+		WithSnapshotRenewalPeriod(0)
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, txOpts)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	docIDFieldName := docIDFieldName(table)
+
+	toBeStored := make([]*structpb.Struct, 0, len(docs))
+
+	for _, doc := range docs {
+		provisionedDocID, ok := doc.Fields[docIDFieldName]
+		if !ok {
+			return fmt.Errorf("%w: field (%s) should be specified when importing a document", ErrIllegalArguments, docIDFieldName)
+		}
+
+		if opts.ConflictPolicy == ImportConflictOverwrite {
+			toBeStored = append(toBeStored, doc)
+			continue
+		}
+
+		docID, err := NewDocumentIDFromHexEncodedString(provisionedDocID.GetStringValue())
+		if err != nil {
+			return err
+		}
+
+		exists, err := e.documentExists(ctx, sqlTx, table, docID)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			toBeStored = append(toBeStored, doc)
+			continue
+		}
+
+		if opts.ConflictPolicy == ImportConflictFail {
+			return fmt.Errorf("%w (%s)", ErrDocumentAlreadyExists, docID.EncodeToHexString())
+		}
+	}
+
+	var txID uint64
+
+	if len(toBeStored) > 0 {
+		txID, _, err = e.upsertDocuments(ctx, sqlTx, collectionName, toBeStored, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	progress.Checkpoint += uint64(len(docs))
+	progress.Imported += uint64(len(toBeStored))
+	progress.Skipped += uint64(len(docs) - len(toBeStored))
+
+	if txID > 0 {
+		progress.TxID = txID
+	}
+
+	if opts.Progress != nil {
+		p := *progress
+		opts.Progress(&p)
+	}
+
+	return nil
+}
+
+func (e *Engine) documentExists(ctx context.Context, sqlTx *sql.SQLTx, table *sql.Table, docID DocumentID) (bool, error) {
+	docIDField := docIDFieldName(table)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(
+		ctx,
+		sqlTx,
+		sql.NewSelectStmt(
+			[]sql.TargetEntry{{Exp: sql.NewColSelector(table.Name(), docIDField)}},
+			sql.NewTableRef(table.Name(), ""),
+			sql.NewCmpBoolExp(sql.EQ, sql.NewColSelector(table.Name(), docIDField), sql.NewBlob(docID)),
+			nil,
+			nil,
+			nil,
+		),
+		nil,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	_, err = r.Read(ctx)
+	if errors.Is(err, sql.ErrNoMoreRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// readJSONLine decodes the next line of the stream into m, io.EOF is returned once the stream is consumed
+func readJSONLine(r *bufio.Reader, m proto.Message) error {
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(line) == 0 {
+			return io.EOF
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			// blank lines are ignored
+			continue
+		}
+
+		err = protojson.Unmarshal(line, m)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrIllegalArguments, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/sql"
+)
+
+// The settings of a collection are kept in the catalog as default values of its columns.
+// The sql engine never uses them, as the document and all of its fields are always
+// provided when a document is stored. Each kind of setting has its own column type:
+//
+//   - the document column (BLOB) holds the strict schema and quota settings: a byte of
+//     flags, followed by the maximum number of documents as a big-endian uint64 when
+//     maxDocumentsFlag is set
+//   - the TTL column (INTEGER) holds the default TTL in seconds
+//   - the column of an indexed STRING field (VARCHAR) holds the collation of the field
+//
+// No other column has a default value, so a setting is found by the type of the column.
+const (
+	strictSchemaFlag byte = 1 << iota
+	maxDocumentsFlag
+)
+
+// documentCounter tracks the number of documents of a collection with a quota.
+// Writers hold the lock until their changes are committed, so the count is
+// consistent under concurrent insertions.
+type documentCounter struct {
+	mutex  sync.Mutex
+	loaded bool
+	count  uint64
+}
+
+func encodeDocumentColumnSettings(collectionOpts *CollectionOptions) []byte {
+	var flags byte
+
+	if collectionOpts.StrictSchema {
+		flags |= strictSchemaFlag
+	}
+
+	if collectionOpts.MaxDocuments == 0 {
+		if flags == 0 {
+			return nil
+		}
+		return []byte{flags}
+	}
+
+	settings := make([]byte, 1+8)
+	settings[0] = flags | maxDocumentsFlag
+	binary.BigEndian.PutUint64(settings[1:], collectionOpts.MaxDocuments)
+
+	return settings
+}
+
+func documentColumnSettings(table *sql.Table) []byte {
+	col, err := table.GetColumnByName(DocumentBLOBField)
+	if err != nil || !col.HasDefault() {
+		return nil
+	}
+
+	defaultValue, ok := col.DefaultValue().(*sql.Blob)
+	if !ok {
+		return nil
+	}
+	return defaultValue.RawValue().([]byte)
+}
+
+// maxDocuments returns the maximum number of documents the collection can hold, zero means no limit
+func maxDocuments(table *sql.Table) uint64 {
+	settings := documentColumnSettings(table)
+	if len(settings) < 1+8 || settings[0]&maxDocumentsFlag == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(settings[1:])
+}
+
+func (e *Engine) documentCounter(table *sql.Table) *documentCounter {
+	e.countersMutex.Lock()
+	defer e.countersMutex.Unlock()
+
+	counter, ok := e.documentCounters[table.ID()]
+	if !ok {
+		counter = &documentCounter{}
+		e.documentCounters[table.ID()] = counter
+	}
+
+	return counter
+}
+
+func (e *Engine) removeDocumentCounter(tableID uint32) {
+	e.countersMutex.Lock()
+	defer e.countersMutex.Unlock()
+
+	delete(e.documentCounters, tableID)
+}
+
+// loadedCount returns the number of documents in the collection, the index is only scanned
+// the first time or after the counter was invalidated. The lock of the counter must be held.
+func (e *Engine) loadedCount(ctx context.Context, counter *documentCounter, table *sql.Table) (uint64, error) {
+	if counter.loaded {
+		return counter.count, nil
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	stats, err := sqlTx.IndexStats(ctx, table.PrimaryIndex())
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	counter.count = stats.Entries
+	counter.loaded = true
+
+	return counter.count, nil
+}
+
+// countNewDocuments returns the number of documents not yet stored in the collection
+func (e *Engine) countNewDocuments(ctx context.Context, sqlTx *sql.SQLTx, table *sql.Table, docIDs []DocumentID) (uint64, error) {
+	var newDocs uint64
+
+	for _, docID := range docIDs {
+		exists, err := e.documentExists(ctx, sqlTx, table, docID)
+		if err != nil {
+			return 0, err
+		}
+
+		if !exists {
+			newDocs++
+		}
+	}
+
+	return newDocs, nil
+}
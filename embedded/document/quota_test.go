@@ -0,0 +1,204 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMaxDocuments(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "limited"
+
+	err := engine.CreateCollectionWithOptions(ctx, "admin", collectionName, "", []*protomodel.Field{
+		{Name: "name", Type: protomodel.FieldType_STRING},
+	}, nil, &CollectionOptions{StrictSchema: true, MaxDocuments: 3})
+	require.NoError(t, err)
+
+	newDoc := func(name string) *structpb.Struct {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{
+			"name": structpb.NewStringValue(name),
+		}}
+	}
+
+	t.Run("the limit should be exposed with the collection", func(t *testing.T) {
+		collection, err := engine.GetCollection(ctx, collectionName)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), collection.MaxDocuments)
+		require.True(t, collection.StrictSchema)
+
+		err = engine.CreateCollection(ctx, "admin", "unlimited", "", nil, nil)
+		require.NoError(t, err)
+
+		collection, err = engine.GetCollection(ctx, "unlimited")
+		require.NoError(t, err)
+		require.Zero(t, collection.MaxDocuments)
+		require.False(t, collection.StrictSchema)
+	})
+
+	t.Run("insertions exceeding the limit should be rejected", func(t *testing.T) {
+		_, _, err := engine.InsertDocuments(ctx, "admin", collectionName, []*structpb.Struct{newDoc("alice"), newDoc("bob")})
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocuments(ctx, "admin", collectionName, []*structpb.Struct{newDoc("carol"), newDoc("dave")})
+		require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, newDoc("carol"))
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, newDoc("dave"))
+		require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+
+		stats, err := engine.GetCollectionStats(ctx, collectionName)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), stats.DocumentCount)
+		require.Equal(t, uint64(3), stats.MaxDocuments)
+	})
+
+	t.Run("failed insertions should not count", func(t *testing.T) {
+		engine := makeEngine(t)
+
+		err := engine.CreateCollectionWithOptions(ctx, "admin", "c", "", nil, nil, &CollectionOptions{MaxDocuments: 2})
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", "c", newDoc("alice"))
+		require.NoError(t, err)
+
+		// the document is rejected once its values are written
+		_, _, err = engine.InsertDocument(ctx, "admin", "c", newDoc(strings.Repeat("x", 64*1024)))
+		require.ErrorIs(t, err, store.ErrMaxValueLenExceeded)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", "c", newDoc("bob"))
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", "c", newDoc("carol"))
+		require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+	})
+
+	t.Run("strict schema should still be enforced", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{Fields: map[string]*structpb.Value{
+			"age": structpb.NewNumberValue(30),
+		}})
+		require.ErrorIs(t, err, ErrSchemaViolation)
+	})
+
+	t.Run("documents of a full collection should be replaceable", func(t *testing.T) {
+		revisions, err := engine.ReplaceDocuments(ctx, "admin", &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    "name",
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    structpb.NewStringValue("carol"),
+				}},
+			}},
+		}, newDoc("caroline"))
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, newDoc("dave"))
+		require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+	})
+
+	t.Run("deleted documents should release room in the collection", func(t *testing.T) {
+		err := engine.DeleteDocuments(ctx, "admin", &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    "name",
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    structpb.NewStringValue("alice"),
+				}},
+			}},
+			Limit: 1,
+		})
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, newDoc("dave"))
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, newDoc("erin"))
+		require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+	})
+
+	t.Run("the count should be loaded from the collection", func(t *testing.T) {
+		reopened, err := NewEngine(engine.sqlEngine.GetStore(), DefaultOptions().WithPrefix(docPrefix))
+		require.NoError(t, err)
+
+		_, _, err = reopened.InsertDocument(ctx, "admin", collectionName, newDoc("erin"))
+		require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+	})
+}
+
+func TestMaxDocumentsWithConcurrentInsertions(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "limited"
+
+	err := engine.CreateCollectionWithOptions(ctx, "admin", collectionName, "", []*protomodel.Field{
+		{Name: "n", Type: protomodel.FieldType_INTEGER},
+	}, nil, &CollectionOptions{MaxDocuments: 10})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	inserted := 0
+	rejected := 0
+
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			_, _, err := engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{Fields: map[string]*structpb.Value{
+				"n": structpb.NewNumberValue(float64(i)),
+			}})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil {
+				inserted++
+				return
+			}
+
+			require.ErrorIs(t, err, ErrDocumentQuotaExceeded)
+			rejected++
+		}(i)
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 10, inserted)
+	require.Equal(t, 15, rejected)
+
+	stats, err := engine.GetCollectionStats(ctx, collectionName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), stats.DocumentCount)
+}
@@ -86,6 +86,34 @@ var structValueToSqlValue = func(value *structpb.Value, sqlType sql.SQLValueType
 	return nil, fmt.Errorf("%w(%s)", ErrUnsupportedType, sqlType)
 }
 
+var sqlValueToStructValue = func(value sql.TypedValue) (*structpb.Value, error) {
+	if value.IsNull() {
+		return structpb.NewNullValue(), nil
+	}
+
+	switch value.Type() {
+	case sql.VarcharType:
+		return structpb.NewStringValue(value.RawValue().(string)), nil
+	case sql.UUIDType:
+		return structpb.NewStringValue(value.RawValue().(uuid.UUID).String()), nil
+	case sql.IntegerType:
+		return structpb.NewNumberValue(float64(value.RawValue().(int64))), nil
+	case sql.BLOBType:
+		docID, err := NewDocumentIDFromRawBytes(value.RawValue().([]byte))
+		if err != nil {
+			return nil, err
+		}
+
+		return structpb.NewStringValue(docID.EncodeToHexString()), nil
+	case sql.Float64Type:
+		return structpb.NewNumberValue(value.RawValue().(float64)), nil
+	case sql.BooleanType:
+		return structpb.NewBoolValue(value.RawValue().(bool)), nil
+	}
+
+	return nil, fmt.Errorf("%w(%s)", ErrUnsupportedType, value.Type())
+}
+
 var protomodelValueTypeToSQLValueType = func(stype protomodel.FieldType) (sql.SQLValueType, error) {
 	switch stype {
 	case protomodel.FieldType_STRING:
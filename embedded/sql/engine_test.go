@@ -6772,6 +6772,58 @@ func TestDefaultValueParsing(t *testing.T) {
 	r.Close()
 }
 
+func TestBlobDefaultValue(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil,
+		`CREATE TABLE blob_defaults (id INTEGER, data BLOB DEFAULT x'0a00ff', PRIMARY KEY id)`, nil)
+	require.NoError(t, err)
+
+	tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer tx.Cancel()
+
+	table, err := tx.Catalog().GetTableByName("blob_defaults")
+	require.NoError(t, err)
+
+	col, err := table.GetColumnByName("data")
+	require.NoError(t, err)
+	require.True(t, col.HasDefault())
+
+	defaultValue, ok := col.DefaultValue().(*Blob)
+	require.True(t, ok)
+	require.Equal(t, []byte{0x0a, 0x00, 0xff}, defaultValue.RawValue())
+}
+
+func TestBlobDefaultValueAfterReopening(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	t.Cleanup(func() { closeStore(t, st) })
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil,
+		`CREATE TABLE blob_defaults (id INTEGER, data BLOB DEFAULT x'0a00ff', empty BLOB DEFAULT x'', PRIMARY KEY id)`, nil)
+	require.NoError(t, err)
+
+	// the catalog is loaded from the store by a new engine
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `INSERT INTO blob_defaults(id) VALUES (1)`, nil)
+	require.NoError(t, err)
+
+	r, err := engine.Query(context.Background(), nil, `SELECT data, empty FROM blob_defaults WHERE id = 1`, nil)
+	require.NoError(t, err)
+	defer r.Close()
+
+	row, err := r.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a, 0x00, 0xff}, row.ValuesByPosition[0].RawValue())
+	require.Equal(t, []byte{}, row.ValuesByPosition[1].RawValue())
+}
+
 func TestAlterColumn(t *testing.T) {
 	engine := setupCommonTest(t)
 
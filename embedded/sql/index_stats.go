@@ -0,0 +1,123 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// IndexStats holds the statistics of an index as seen by a transaction
+type IndexStats struct {
+	Index *Index
+	// Entries is the number of live entries in the index
+	Entries uint64
+	// DistinctKeys is the number of distinct combinations of indexed values
+	DistinctKeys uint64
+	// Size is the approximate number of bytes taken by the entries (keys and values),
+	// previous revisions and deleted entries are not taken into account
+	Size uint64
+}
+
+// IndexStats collects the statistics of the index. Only index keys and value references
+// are read, so row payloads are neither fetched nor decoded.
+func (sqlTx *SQLTx) IndexStats(ctx context.Context, index *Index) (*IndexStats, error) {
+	if index == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	prefix := MapKey(sqlTx.sqlPrefix(), MappedPrefix, EncodeID(index.table.id), EncodeID(index.id))
+
+	r, err := sqlTx.newKeyReader(store.KeyReaderSpec{
+		Prefix:  prefix,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	stats := &IndexStats{Index: index}
+
+	var prevIndexedValues []byte
+
+	for {
+		mkey, valRef, err := r.Read(ctx)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return stats, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(mkey) < len(prefix) {
+			return nil, ErrCorruptedData
+		}
+
+		off := len(prefix)
+
+		for _, col := range index.cols {
+			_, n, err := DecodeValueFromKey(mkey[off:], col.colType, col.MaxLen())
+			if err != nil {
+				return nil, err
+			}
+			off += n
+		}
+
+		// entries are sorted by key, so equal indexed values are contiguous
+		indexedValues := mkey[len(prefix):off]
+
+		if stats.Entries == 0 || !bytes.Equal(prevIndexedValues, indexedValues) {
+			stats.DistinctKeys++
+			prevIndexedValues = append(prevIndexedValues[:0], indexedValues...)
+		}
+
+		stats.Entries++
+		stats.Size += uint64(len(mkey)) + uint64(valRef.Len())
+	}
+}
+
+// EstimateRows estimates the number of index entries read by a scan using the specs.
+// When every column of the index is restricted to a single value, the average number
+// of entries per distinct key is returned, otherwise all the entries of the index are
+// assumed to be read.
+func (sqlTx *SQLTx) EstimateRows(ctx context.Context, scanSpecs *ScanSpecs) (uint64, error) {
+	if scanSpecs == nil || scanSpecs.Index == nil {
+		return 0, ErrIllegalArguments
+	}
+
+	stats, err := sqlTx.IndexStats(ctx, scanSpecs.Index)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, col := range scanSpecs.Index.cols {
+		colRange, ok := scanSpecs.rangesByColID[col.id]
+		if !ok || !colRange.unitary() {
+			return stats.Entries, nil
+		}
+	}
+
+	if stats.DistinctKeys == 0 {
+		return 0, nil
+	}
+
+	return (stats.Entries + stats.DistinctKeys - 1) / stats.DistinctKeys, nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexStats(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE products (
+			id INTEGER AUTO_INCREMENT,
+			category VARCHAR[32],
+			name VARCHAR[64],
+			PRIMARY KEY id
+		);
+		CREATE INDEX ON products(category);
+		CREATE UNIQUE INDEX ON products(name);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(ctx, nil, `
+		INSERT INTO products(category, name) VALUES
+			('fruit', 'apple'),
+			('fruit', 'banana'),
+			('vegetable', 'carrot'),
+			(NULL, 'salt')
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(ctx, nil, "DELETE FROM products WHERE name = 'banana'", nil)
+	require.NoError(t, err)
+
+	tx, err := engine.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer tx.Cancel()
+
+	_, err = tx.IndexStats(ctx, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	table, err := tx.Catalog().GetTableByName("products")
+	require.NoError(t, err)
+
+	expected := map[string]struct{ entries, distinctKeys uint64 }{
+		"products(id)":       {entries: 3, distinctKeys: 3},
+		"products(category)": {entries: 3, distinctKeys: 3},
+		"products(name)":     {entries: 3, distinctKeys: 3},
+	}
+
+	for _, index := range table.GetIndexes() {
+		stats, err := tx.IndexStats(ctx, index)
+		require.NoError(t, err)
+		require.Equal(t, index, stats.Index)
+
+		exp, ok := expected[index.Name()]
+		require.True(t, ok, index.Name())
+		require.Equal(t, exp.entries, stats.Entries, index.Name())
+		require.Equal(t, exp.distinctKeys, stats.DistinctKeys, index.Name())
+		require.Greater(t, stats.Size, uint64(0))
+	}
+
+	_, _, err = engine.Exec(ctx, nil, "INSERT INTO products(category, name) VALUES ('fruit', 'cherry'), ('fruit', 'kiwi')", nil)
+	require.NoError(t, err)
+
+	tx, err = engine.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer tx.Cancel()
+
+	table, err = tx.Catalog().GetTableByName("products")
+	require.NoError(t, err)
+
+	index, err := table.GetIndexByName("products(category)")
+	require.NoError(t, err)
+
+	stats, err := tx.IndexStats(ctx, index)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), stats.Entries)
+	require.Equal(t, uint64(3), stats.DistinctKeys)
+}
+
+func TestEstimateRows(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE products (
+			id INTEGER AUTO_INCREMENT,
+			category VARCHAR[32],
+			price INTEGER,
+			PRIMARY KEY id
+		);
+		CREATE INDEX ON products(category, price);
+
+		INSERT INTO products(category, price) VALUES
+			('fruit', 10),
+			('fruit', 20),
+			('fruit', 20),
+			('vegetable', 5);
+	`, nil)
+	require.NoError(t, err)
+
+	tx, err := engine.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer tx.Cancel()
+
+	_, err = tx.EstimateRows(ctx, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	t.Run("full scan", func(t *testing.T) {
+		r, err := engine.Query(ctx, tx, "SELECT * FROM products WHERE price > 5", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		specs := r.ScanSpecs()
+		require.True(t, specs.Index.IsPrimary())
+		require.Empty(t, specs.IndexRanges())
+		require.False(t, specs.SortRequired())
+
+		estimated, err := tx.EstimateRows(ctx, specs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), estimated)
+
+		for {
+			_, err := r.Read(ctx)
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, uint64(4), specs.RowsScanned())
+	})
+
+	t.Run("range scan", func(t *testing.T) {
+		r, err := engine.Query(ctx, tx, "SELECT * FROM products USE INDEX ON (category, price) WHERE category = 'fruit' AND price >= 15", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		specs := r.ScanSpecs()
+		require.Equal(t, "products(category,price)", specs.Index.Name())
+
+		ranges := specs.IndexRanges()
+		require.Len(t, ranges, 2)
+
+		require.Equal(t, "category", ranges[0].Column.Name())
+		require.Equal(t, "fruit", ranges[0].Lower.RawValue())
+		require.True(t, ranges[0].LowerInclusive)
+		require.Equal(t, "fruit", ranges[0].Upper.RawValue())
+		require.True(t, ranges[0].UpperInclusive)
+
+		require.Equal(t, "price", ranges[1].Column.Name())
+		require.Equal(t, int64(15), ranges[1].Lower.RawValue())
+		require.True(t, ranges[1].LowerInclusive)
+		require.Nil(t, ranges[1].Upper)
+
+		estimated, err := tx.EstimateRows(ctx, specs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), estimated)
+
+		n := 0
+		for {
+			_, err := r.Read(ctx)
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+			n++
+		}
+
+		require.Equal(t, 2, n)
+		require.Equal(t, uint64(2), specs.RowsScanned())
+	})
+
+	t.Run("point scan", func(t *testing.T) {
+		r, err := engine.Query(ctx, tx, "SELECT * FROM products USE INDEX ON (category, price) WHERE category = 'fruit' AND price = 20 ORDER BY id DESC", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		specs := r.ScanSpecs()
+		require.Len(t, specs.IndexRanges(), 2)
+		require.True(t, specs.SortRequired())
+
+		// 4 entries over 3 distinct keys
+		estimated, err := tx.EstimateRows(ctx, specs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), estimated)
+	})
+}
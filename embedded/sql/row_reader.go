@@ -54,6 +54,76 @@ type ScanSpecs struct {
 	// Columns absent from the map are skipped (offset advanced, no allocation).
 	// nil means decode all columns (backward-compatible default).
 	neededColIDs map[uint32]bool
+	// rowsScanned is the number of entries read from the index by the readers using the specs
+	rowsScanned uint64
+}
+
+// IndexRange is the range of values of an index column a scan is restricted to
+type IndexRange struct {
+	Column *Column
+	// Lower is the lower bound of the range, nil if there is no lower bound
+	Lower          TypedValue
+	LowerInclusive bool
+	// Upper is the upper bound of the range, nil if there is no upper bound
+	Upper          TypedValue
+	UpperInclusive bool
+}
+
+// IndexRanges returns the ranges of values of the leading index columns the scan is
+// restricted to, in the order of the index columns. No range is returned when the
+// whole index is scanned.
+func (s *ScanSpecs) IndexRanges() []*IndexRange {
+	if s.Index == nil {
+		return nil
+	}
+
+	var ranges []*IndexRange
+
+	// bounds are taken into account in the same way as when building the scan keys
+	lowerReady, upperReady := false, false
+
+	for _, col := range s.Index.cols {
+		colRange, ok := s.rangesByColID[col.id]
+		if !ok {
+			break
+		}
+
+		r := &IndexRange{Column: col}
+
+		if !lowerReady && colRange.lRange != nil {
+			r.Lower = colRange.lRange.val
+			r.LowerInclusive = colRange.lRange.inclusive
+		} else {
+			lowerReady = true
+		}
+
+		if !upperReady && colRange.hRange != nil {
+			r.Upper = colRange.hRange.val
+			r.UpperInclusive = colRange.hRange.inclusive
+		} else {
+			upperReady = true
+		}
+
+		if r.Lower == nil && r.Upper == nil {
+			break
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	return ranges
+}
+
+// SortRequired returns true if rows are sorted after being read
+// because the index does not match the required sorting
+func (s *ScanSpecs) SortRequired() bool {
+	return len(s.groupBySortExps) > 0 || len(s.orderBySortExps) > 0
+}
+
+// RowsScanned returns the number of entries read from the index so far,
+// including the rows discarded afterwards by the query conditions
+func (s *ScanSpecs) RowsScanned() uint64 {
+	return s.rowsScanned
 }
 
 func (s *ScanSpecs) extraCols() int {
@@ -470,6 +540,8 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 		return nil, err
 	}
 
+	r.scanSpecs.rowsScanned++
+
 	v, err := vref.Resolve()
 	if err != nil {
 		return nil, err
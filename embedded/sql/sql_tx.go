@@ -105,6 +105,16 @@ func (sqlTx *SQLTx) TxHeader() *store.TxHeader {
 	return sqlTx.txHeader
 }
 
+// SnapshotTxID returns the ID of the latest transaction visible within the transaction,
+// as seen by the catalog snapshot
+func (sqlTx *SQLTx) SnapshotTxID() (uint64, error) {
+	prefix := make([]byte, 0, len(sqlTx.sqlPrefix())+len(catalogPrefix))
+	prefix = append(prefix, sqlTx.sqlPrefix()...)
+	prefix = append(prefix, catalogPrefix...)
+
+	return sqlTx.tx.SnapshotTxID(prefix)
+}
+
 func (sqlTx *SQLTx) sqlPrefix() []byte {
 	return sqlTx.engine.prefix
 }
@@ -673,11 +673,20 @@ func (stmt *CreateTableStmt) primaryKeyCols() []string {
 	return nil
 }
 
+// defaultValueSQL renders the default value of a column as it's parsed when the catalog is loaded
+func defaultValueSQL(val ValueExp) string {
+	if blob, ok := val.(*Blob); ok {
+		// the hex encoding returned by String() is not a valid blob literal
+		return fmt.Sprintf("x'%s'", blob.String())
+	}
+	return val.String()
+}
+
 func persistColumn(tx *SQLTx, col *Column) error {
 	var defaultSQL string
 	hasDefault := col.defaultValue != nil
 	if hasDefault {
-		defaultSQL = col.defaultValue.String()
+		defaultSQL = defaultValueSQL(col.defaultValue)
 	}
 
 	colNameBytes := []byte(col.Name())
@@ -824,6 +833,12 @@ func NewColSpec(name string, colType SQLValueType, maxLen int, autoIncrement boo
 	}
 }
 
+// WithDefaultValue sets the value assigned to the column when it is not specified on insertion
+func (cs *ColSpec) WithDefaultValue(val ValueExp) *ColSpec {
+	cs.defaultValue = val
+	return cs
+}
+
 type CreateIndexStmt struct {
 	unique      bool
 	ifNotExists bool
@@ -3767,6 +3782,12 @@ func NewSelectStmt(
 	}
 }
 
+// WithIndexOn sets the index used to resolve the query, as done with USE INDEX ON
+func (stmt *SelectStmt) WithIndexOn(cols []string) *SelectStmt {
+	stmt.indexOn = cols
+	return stmt
+}
+
 func (stmt *SelectStmt) readOnly() bool {
 	return true
 }
@@ -5665,6 +5686,19 @@ func NewTableRef(table string, as string) *tableRef {
 	}
 }
 
+// UntilTx restricts the rows of the table to the values they had once the
+// transaction was committed, as done with UNTIL TX
+func (ref *tableRef) UntilTx(txID uint64) *tableRef {
+	ref.period.end = &openPeriod{
+		inclusive: true,
+		instant: periodInstant{
+			exp:         NewInteger(int64(txID)),
+			instantType: txInstant,
+		},
+	}
+	return ref
+}
+
 type tableRef struct {
 	table   string
 	history bool
@@ -6489,6 +6523,15 @@ func NewLikeBoolExp(val ValueExp, notLike bool, pattern ValueExp) *LikeBoolExp {
 	}
 }
 
+func NewILikeBoolExp(val ValueExp, notLike bool, pattern ValueExp) *LikeBoolExp {
+	return &LikeBoolExp{
+		val:             val,
+		notLike:         notLike,
+		caseInsensitive: true,
+		pattern:         pattern,
+	}
+}
+
 func (bexp *LikeBoolExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
 	if bexp.val == nil || bexp.pattern == nil {
 		return AnyType, fmt.Errorf("error in 'LIKE' clause: %w", ErrInvalidCondition)
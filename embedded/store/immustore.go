@@ -1220,6 +1220,22 @@ func (s *ImmuStore) PrecommittedAlh() (uint64, [sha256.Size]byte) {
 	s.commitStateRWMutex.RLock()
 	defer s.commitStateRWMutex.RUnlock()
 
+	return s.precommittedAlhUnlocked()
+}
+
+// CommitState returns the last committed and the last (durable) precommitted transactions,
+// both read at the same point in time so they are consistent with each other
+func (s *ImmuStore) CommitState() (committedTxID uint64, committedAlh [sha256.Size]byte, precommittedTxID uint64, precommittedAlh [sha256.Size]byte) {
+	s.commitStateRWMutex.RLock()
+	defer s.commitStateRWMutex.RUnlock()
+
+	precommittedTxID, precommittedAlh = s.precommittedAlhUnlocked()
+
+	return s.committedTxID, s.committedAlh, precommittedTxID, precommittedAlh
+}
+
+// precommittedAlhUnlocked requires the caller to have already acquired the commitStateRWMutex lock
+func (s *ImmuStore) precommittedAlhUnlocked() (uint64, [sha256.Size]byte) {
 	durablePrecommittedTxID, _, _ := s.durablePrecommitWHub.Status()
 
 	if durablePrecommittedTxID == s.committedTxID {
@@ -2203,6 +2203,12 @@ func TestImmudbStoreConsistencyProofReopened(t *testing.T) {
 		currentID, currentAlh := immuStore.CommittedAlh()
 		require.Equal(t, txhdr.ID, currentID)
 		require.Equal(t, txhdr.Alh(), currentAlh)
+
+		committedID, committedAlh, precommittedID, precommittedAlh := immuStore.CommitState()
+		require.Equal(t, txhdr.ID, committedID)
+		require.Equal(t, txhdr.Alh(), committedAlh)
+		require.Equal(t, txhdr.ID, precommittedID)
+		require.Equal(t, txhdr.Alh(), precommittedAlh)
 	}
 
 	err = immuStore.Sync()
@@ -215,6 +215,25 @@ func (tx *OngoingTx) Metadata() *TxMetadata {
 	return tx.metadata
 }
 
+// SnapshotTxID returns the ID of the latest transaction visible when reading keys with the given prefix,
+// the snapshot is taken if it was not needed so far
+func (tx *OngoingTx) SnapshotTxID(prefix []byte) (uint64, error) {
+	if tx.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if tx.IsWriteOnly() {
+		return 0, ErrWriteOnlyTx
+	}
+
+	snap, err := tx.snap(prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	return snap.Ts(), nil
+}
+
 func (tx *OngoingTx) snap(key []byte) (*Snapshot, error) {
 	for _, snap := range tx.snapshots {
 		if hasPrefix(key, snap.prefix) {
@@ -21,6 +21,7 @@ type DocumentServiceClient interface {
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
 	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
+	GetCollectionStats(ctx context.Context, in *GetCollectionStatsRequest, opts ...grpc.CallOption) (*GetCollectionStatsResponse, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*AddFieldResponse, error)
@@ -71,6 +72,15 @@ func (c *documentServiceClient) GetCollection(ctx context.Context, in *GetCollec
 	return out, nil
 }
 
+func (c *documentServiceClient) GetCollectionStats(ctx context.Context, in *GetCollectionStatsRequest, opts ...grpc.CallOption) (*GetCollectionStatsResponse, error) {
+	out := new(GetCollectionStatsResponse)
+	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/GetCollectionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
 	out := new(UpdateCollectionResponse)
 	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/UpdateCollection", in, out, opts...)
@@ -195,6 +205,7 @@ type DocumentServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
 	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
 	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
+	GetCollectionStats(context.Context, *GetCollectionStatsRequest) (*GetCollectionStatsResponse, error)
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	AddField(context.Context, *AddFieldRequest) (*AddFieldResponse, error)
@@ -223,6 +234,9 @@ func (UnimplementedDocumentServiceServer) GetCollections(context.Context, *GetCo
 func (UnimplementedDocumentServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
 }
+func (UnimplementedDocumentServiceServer) GetCollectionStats(context.Context, *GetCollectionStatsRequest) (*GetCollectionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStats not implemented")
+}
 func (UnimplementedDocumentServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
 }
@@ -328,6 +342,24 @@ func _DocumentService_GetCollection_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_GetCollectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetCollectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.model.DocumentService/GetCollectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetCollectionStats(ctx, req.(*GetCollectionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCollectionRequest)
 	if err := dec(in); err != nil {
@@ -581,6 +613,10 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCollection",
 			Handler:    _DocumentService_GetCollection_Handler,
 		},
+		{
+			MethodName: "GetCollectionStats",
+			Handler:    _DocumentService_GetCollectionStats_Handler,
+		},
 		{
 			MethodName: "UpdateCollection",
 			Handler:    _DocumentService_UpdateCollection_Handler,
@@ -24,6 +24,7 @@ type ImmuServiceClient interface {
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ChangePermission(ctx context.Context, in *ChangePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ChangeSQLPrivileges(ctx context.Context, in *ChangeSQLPrivilegesRequest, opts ...grpc.CallOption) (*ChangeSQLPrivilegesResponse, error)
+	ChangeCollectionPermission(ctx context.Context, in *ChangeCollectionPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetActiveUser(ctx context.Context, in *SetActiveUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deprecated: Do not use.
 	UpdateAuthConfig(ctx context.Context, in *AuthConfig, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -35,7 +36,7 @@ type ImmuServiceClient interface {
 	NewTx(ctx context.Context, in *NewTxRequest, opts ...grpc.CallOption) (*NewTxResponse, error)
 	Commit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CommittedSQLTx, error)
 	Rollback(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	TxSQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TxSQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*TxSQLExecResult, error)
 	TxSQLQuery(ctx context.Context, in *SQLQueryRequest, opts ...grpc.CallOption) (ImmuService_TxSQLQueryClient, error)
 	// Deprecated: Do not use.
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
@@ -170,6 +171,15 @@ func (c *immuServiceClient) ChangeSQLPrivileges(ctx context.Context, in *ChangeS
 	return out, nil
 }
 
+func (c *immuServiceClient) ChangeCollectionPermission(ctx context.Context, in *ChangeCollectionPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ChangeCollectionPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) SetActiveUser(ctx context.Context, in *SetActiveUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/SetActiveUser", in, out, opts...)
@@ -253,8 +263,8 @@ func (c *immuServiceClient) Rollback(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
-func (c *immuServiceClient) TxSQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *immuServiceClient) TxSQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*TxSQLExecResult, error) {
+	out := new(TxSQLExecResult)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TxSQLExec", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1115,6 +1125,7 @@ type ImmuServiceServer interface {
 	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
 	ChangePermission(context.Context, *ChangePermissionRequest) (*emptypb.Empty, error)
 	ChangeSQLPrivileges(context.Context, *ChangeSQLPrivilegesRequest) (*ChangeSQLPrivilegesResponse, error)
+	ChangeCollectionPermission(context.Context, *ChangeCollectionPermissionRequest) (*emptypb.Empty, error)
 	SetActiveUser(context.Context, *SetActiveUserRequest) (*emptypb.Empty, error)
 	// Deprecated: Do not use.
 	UpdateAuthConfig(context.Context, *AuthConfig) (*emptypb.Empty, error)
@@ -1126,7 +1137,7 @@ type ImmuServiceServer interface {
 	NewTx(context.Context, *NewTxRequest) (*NewTxResponse, error)
 	Commit(context.Context, *emptypb.Empty) (*CommittedSQLTx, error)
 	Rollback(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	TxSQLExec(context.Context, *SQLExecRequest) (*emptypb.Empty, error)
+	TxSQLExec(context.Context, *SQLExecRequest) (*TxSQLExecResult, error)
 	TxSQLQuery(*SQLQueryRequest, ImmuService_TxSQLQueryServer) error
 	// Deprecated: Do not use.
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
@@ -1227,6 +1238,9 @@ func (UnimplementedImmuServiceServer) ChangePermission(context.Context, *ChangeP
 func (UnimplementedImmuServiceServer) ChangeSQLPrivileges(context.Context, *ChangeSQLPrivilegesRequest) (*ChangeSQLPrivilegesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeSQLPrivileges not implemented")
 }
+func (UnimplementedImmuServiceServer) ChangeCollectionPermission(context.Context, *ChangeCollectionPermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCollectionPermission not implemented")
+}
 func (UnimplementedImmuServiceServer) SetActiveUser(context.Context, *SetActiveUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetActiveUser not implemented")
 }
@@ -1254,7 +1268,7 @@ func (UnimplementedImmuServiceServer) Commit(context.Context, *emptypb.Empty) (*
 func (UnimplementedImmuServiceServer) Rollback(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
 }
-func (UnimplementedImmuServiceServer) TxSQLExec(context.Context, *SQLExecRequest) (*emptypb.Empty, error) {
+func (UnimplementedImmuServiceServer) TxSQLExec(context.Context, *SQLExecRequest) (*TxSQLExecResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxSQLExec not implemented")
 }
 func (UnimplementedImmuServiceServer) TxSQLQuery(*SQLQueryRequest, ImmuService_TxSQLQueryServer) error {
@@ -1536,6 +1550,24 @@ func _ImmuService_ChangeSQLPrivileges_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_ChangeCollectionPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCollectionPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ChangeCollectionPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ChangeCollectionPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ChangeCollectionPermission(ctx, req.(*ChangeCollectionPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_SetActiveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetActiveUserRequest)
 	if err := dec(in); err != nil {
@@ -2869,6 +2901,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeSQLPrivileges",
 			Handler:    _ImmuService_ChangeSQLPrivileges_Handler,
 		},
+		{
+			MethodName: "ChangeCollectionPermission",
+			Handler:    _ImmuService_ChangeCollectionPermission_Handler,
+		},
 		{
 			MethodName: "SetActiveUser",
 			Handler:    _ImmuService_SetActiveUser_Handler,
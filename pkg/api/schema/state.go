@@ -19,8 +19,10 @@ package schema
 import (
 	"crypto/ecdsa"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/binary"
 	"errors"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/signer"
 )
@@ -51,3 +53,13 @@ func (state *ImmutableState) CheckSignature(key *ecdsa.PublicKey) error {
 
 	return signer.Verify(state.ToBytes(), state.Signature.Signature, key)
 }
+
+// CheckTimestamp verifies the timestamp token attached to the signature was issued over the state
+// by a timestamp authority trusted by roots (system roots are used if nil), it returns the attested time
+func (state *ImmutableState) CheckTimestamp(roots *x509.CertPool) (time.Time, error) {
+	if state.Signature == nil || len(state.Signature.TimestampToken) == 0 {
+		return time.Time{}, errors.New("no timestamp token provided")
+	}
+
+	return signer.VerifyTimestamp(state.ToBytes(), state.Signature.TimestampToken, roots)
+}
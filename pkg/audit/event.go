@@ -17,6 +17,7 @@ limitations under the License.
 package audit
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync/atomic"
@@ -45,6 +46,14 @@ type AuditEvent struct {
 	ErrorMsg   string    `json:"err,omitempty"`
 	DurationMs int64     `json:"dur_ms"`
 	SessionID  string    `json:"sid,omitempty"`
+
+	// Document operations additionally record the collection, the transaction
+	// and the documents involved. Documents are only set when payloads are enabled.
+	Collection  string            `json:"collection,omitempty"`
+	TxID        uint64            `json:"tx,omitempty"`
+	DocumentIDs []string          `json:"doc_ids,omitempty"`
+	Fields      []string          `json:"fields,omitempty"`
+	Documents   []json.RawMessage `json:"docs,omitempty"`
 }
 
 // KeyPrefix is the prefix for all audit event keys in the KV store.
@@ -45,6 +45,7 @@ var maintenanceMethods = map[string]struct{}{
 	"VerifiableSQLGet":    {},
 	"CreateCollection":    {},
 	"GetCollection":       {},
+	"GetCollectionStats":  {},
 	"GetCollections":      {},
 	"UpdateCollection":    {},
 	"DeleteCollection":    {},
@@ -121,22 +122,23 @@ var methodsPermissions = map[string][]uint32{
 	"DescribeTable":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"VerifiableSQLGet":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
-	"CreateCollection": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"GetCollection":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"GetCollections":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"UpdateCollection": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"DeleteCollection": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"AddField":         {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"RemoveField":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"CreateIndex":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"DeleteIndex":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"InsertDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"ReplaceDocuments": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"DeleteDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SearchDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"CountDocuments":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"AuditDocument":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"ProofDocument":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CreateCollection":   {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"GetCollection":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"GetCollectionStats": {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"GetCollections":     {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"UpdateCollection":   {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteCollection":   {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"AddField":           {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"RemoveField":        {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"CreateIndex":        {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteIndex":        {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"InsertDocuments":    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"ReplaceDocuments":   {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteDocuments":    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SearchDocuments":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CountDocuments":     {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"AuditDocument":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"ProofDocument":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
 	// admin methods
 	"ListUsers":        {PermissionSysAdmin, PermissionAdmin},
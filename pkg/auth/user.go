@@ -30,6 +30,13 @@ type Permission struct {
 	Database   string `json:"database"`   // databases the user has access to
 }
 
+// CollectionPermission per collection
+type CollectionPermission struct {
+	Permission uint32 `json:"permission"` // permission of type auth.PermissionR or auth.PermissionRW
+	Database   string `json:"database"`   // database of the collection
+	Collection string `json:"collection"` // collection the user has access to
+}
+
 type SQLPrivilege struct {
 	Privilege string `json:"privilege"` // sql privilege
 	Database  string `json:"database"`  // database to which the privilege applies
@@ -46,6 +53,8 @@ type User struct {
 	IsSysAdmin     bool           `json:"-"`         // for the sysadmin we'll use this instead of adding all db and permissions to Permissions, to save some cpu cycles
 	CreatedBy      string         `json:"createdBy"` // user which created this user
 	CreatedAt      time.Time      `json:"createdat"` // time in which this user is created/updated
+
+	CollectionPermissions []CollectionPermission `json:"collectionPermissions,omitempty"`
 }
 
 var (
@@ -139,6 +148,53 @@ func (u *User) GrantPermission(database string, permission uint32) bool {
 	return true
 }
 
+// HasCollectionPermission checks if user can access the collection with such permission.
+// Users without collection permissions on the database are only subject to the database permission,
+// once granted a collection permission a user can only access the collections it was granted.
+// Admins of the database can access any collection.
+func (u *User) HasCollectionPermission(database, collection string, permission uint32) bool {
+	if u.IsSysAdmin || u.HasPermission(database, PermissionAdmin) {
+		return true
+	}
+
+	restricted := false
+
+	for _, val := range u.CollectionPermissions {
+		if val.Database != database {
+			continue
+		}
+
+		if val.Collection == collection {
+			return val.Permission >= permission
+		}
+
+		restricted = true
+	}
+
+	return !restricted
+}
+
+// RevokeCollectionPermission revoke collection permission from user
+func (u *User) RevokeCollectionPermission(database, collection string) bool {
+	for i, val := range u.CollectionPermissions {
+		if val.Database == database && val.Collection == collection {
+			u.CollectionPermissions = append(u.CollectionPermissions[:i], u.CollectionPermissions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// GrantCollectionPermission add permission to collection
+func (u *User) GrantCollectionPermission(database, collection string, permission uint32) bool {
+	// first remove any previous permission for this collection
+	u.RevokeCollectionPermission(database, collection)
+
+	perm := CollectionPermission{Permission: permission, Database: database, Collection: collection}
+	u.CollectionPermissions = append(u.CollectionPermissions, perm)
+	return true
+}
+
 // GrantSQLPrivilege grants sql privilege on the specified database
 func (u *User) GrantSQLPrivileges(database string, privileges []string) bool {
 	for _, p := range privileges {
@@ -70,3 +70,36 @@ func TestUser(t *testing.T) {
 		t.Errorf("WhichPermission sysadmin fail")
 	}
 }
+
+func TestUserCollectionPermissions(t *testing.T) {
+	u := User{}
+	u.GrantPermission("db1", PermissionRW)
+	u.GrantPermission("db2", PermissionRW)
+
+	// no collection permission, only the database permission applies
+	require.True(t, u.HasCollectionPermission("db1", "tenant1", PermissionRW))
+	require.True(t, u.HasCollectionPermission("db1", "tenant2", PermissionRW))
+
+	u.GrantCollectionPermission("db1", "tenant1", PermissionR)
+	require.True(t, u.HasCollectionPermission("db1", "tenant1", PermissionR))
+	require.False(t, u.HasCollectionPermission("db1", "tenant1", PermissionRW))
+	require.False(t, u.HasCollectionPermission("db1", "tenant2", PermissionR))
+	require.True(t, u.HasCollectionPermission("db2", "tenant2", PermissionRW))
+
+	u.GrantCollectionPermission("db1", "tenant1", PermissionRW)
+	require.Len(t, u.CollectionPermissions, 1)
+	require.True(t, u.HasCollectionPermission("db1", "tenant1", PermissionRW))
+
+	require.True(t, u.RevokeCollectionPermission("db1", "tenant1"))
+	require.False(t, u.RevokeCollectionPermission("db1", "tenant1"))
+	require.Empty(t, u.CollectionPermissions)
+	require.True(t, u.HasCollectionPermission("db1", "tenant2", PermissionRW))
+
+	u.GrantCollectionPermission("db1", "tenant1", PermissionR)
+	u.GrantPermission("db1", PermissionAdmin)
+	require.True(t, u.HasCollectionPermission("db1", "tenant2", PermissionRW))
+
+	u.GrantPermission("db1", PermissionRW)
+	u.IsSysAdmin = true
+	require.True(t, u.HasCollectionPermission("db1", "tenant2", PermissionRW))
+}
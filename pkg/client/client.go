@@ -20,6 +20,7 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"crypto/sha256"
@@ -29,6 +30,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -95,6 +97,11 @@ type ImmuClient interface {
 	// Note: it is important to call CloseSession() once the session is no longer needed.
 	OpenSession(ctx context.Context, user []byte, pass []byte, database string) (err error)
 
+	// ResumeSession opens a new connection to the server and binds it to a session previously
+	// opened with the same credentials and database, e.g. after the former connection was lost.
+	// Ongoing transactions of the session can still be used once the session has been resumed.
+	ResumeSession(ctx context.Context, user []byte, pass []byte, database string, sessionID string) (err error)
+
 	// CloseSession closes the current session and the connection to the server,
 	// this call also allows the server to free up all resources allocated for a session
 	// (without explicit call, the server will only free resources after session inactivity timeout).
@@ -149,6 +156,18 @@ type ImmuClient interface {
 	//    by the new permission (even if the user had higher permission before)
 	ChangePermission(ctx context.Context, action schema.PermissionAction, username string, database string, permissions uint32) error
 
+	// ChangeCollectionPermission grants or revokes permission to one collection for given user.
+	//
+	// This call requires SysAdmin or admin permission to the database of the collection.
+	//
+	// The permission argument is used when granting permission and can be one of those values:
+	//   - 1 (auth.PermissionR) - read-only access
+	//   - 2 (auth.PermissionRW) - read-write access
+	//
+	// Once granted a permission on a collection, the user can only access the collections of
+	// the database it was granted permission on, within the limits of its database permission.
+	ChangeCollectionPermission(ctx context.Context, action schema.PermissionAction, username string, database string, collection string, permission uint32) error
+
 	// UpdateAuthConfig is no longer supported.
 	//
 	// Deprecated: will be removed in future versions.
@@ -180,6 +199,9 @@ type ImmuClient interface {
 	// WithServerSigningPubKey sets up public key for server's state validation.
 	WithServerSigningPubKey(serverSigningPubKey *ecdsa.PublicKey) *immuClient
 
+	// WithServerTimestampRoots sets up the trusted timestamp authorities roots for server's state timestamp validation.
+	WithServerTimestampRoots(roots *x509.CertPool) *immuClient
+
 	// WithStreamServiceFactory sets up stream factory for the client.
 	WithStreamServiceFactory(ssf stream.ServiceFactory) *immuClient
 
@@ -418,6 +440,13 @@ type ImmuClient interface {
 	// GetAll retrieves multiple entries in a single call.
 	GetAll(ctx context.Context, keys [][]byte) (*schema.Entries, error)
 
+	// VerifiedGetAll retrieves multiple entries from a single snapshot of the database,
+	// verifying all of them against a single state.
+	//
+	// The local state is advanced only once, after all the entries have been verified.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedGetAll(ctx context.Context, keys [][]byte) (*schema.Entries, error)
+
 	// Delete performs a logical deletion for a list of keys marking them as deleted.
 	Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error)
 
@@ -540,6 +569,7 @@ type immuClient struct {
 	StateService         state.StateService
 	Tkns                 tokenservice.TokenService
 	serverSigningPubKey  *ecdsa.PublicKey
+	serverTimestampRoots *x509.CertPool
 	StreamServiceFactory stream.ServiceFactory
 	SessionID            string
 	HeartBeater          HeartBeater
@@ -585,6 +615,14 @@ func NewImmuClient(options *Options) (*immuClient, error) {
 		c.WithServerSigningPubKey(pk)
 	}
 
+	if options.ServerTimestampRoots != "" {
+		roots, err := signer.ParseCertPoolFile(options.ServerTimestampRoots)
+		if err != nil {
+			return nil, err
+		}
+		c.WithServerTimestampRoots(roots)
+	}
+
 	options.DialOptions = c.SetupDialOptions(options)
 
 	if db, err := c.Tkns.GetDatabase(); err == nil && len(db) > 0 {
@@ -1132,6 +1170,131 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 		return nil, err
 	}
 
+	vEntry, newState, err := c.verifiableGet(ctx, kReq, state)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return vEntry.Entry, nil
+}
+
+// VerifiedGetAll reads and verifies the entries of multiple keys against a single state.
+//
+// All the entries are read from the same snapshot of the database and each of them is then
+// proven at the transaction it was read at. The most recent one is proven first, so the
+// remaining proofs link their entries to the very same state, which is then checked and
+// saved locally only once. If any of the verifications does not succeed, the local state
+// is left untouched and the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedGetAll(ctx context.Context, keys [][]byte) (*schema.Entries, error) {
+	start := time.Now()
+	defer c.debugElapsedTime("VerifiedGetAll", start)
+
+	if len(keys) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	snapEntries, err := c.ServiceClient.GetAll(ctx, &schema.KeyListRequest{Keys: keys})
+	if err != nil {
+		return nil, err
+	}
+
+	// missing keys are skipped by GetAll, the rest are returned in the requested order
+	atTxs := make([]uint64, len(keys))
+
+	j := 0
+	for i, key := range keys {
+		if j == len(snapEntries.Entries) || !entryReadByKey(snapEntries.Entries[j], key) {
+			return nil, fmt.Errorf("%w: %s", store.ErrKeyNotFound, key)
+		}
+
+		atTxs[i] = entryTxID(snapEntries.Entries[j])
+		j++
+	}
+
+	if j != len(snapEntries.Entries) {
+		return nil, store.ErrCorruptedData
+	}
+
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return atTxs[order[i]] > atTxs[order[j]]
+	})
+
+	entries := &schema.Entries{Entries: make([]*schema.Entry, len(keys))}
+
+	for _, i := range order {
+		vEntry, newState, err := c.verifiableGet(ctx, &schema.KeyRequest{Key: keys[i], AtTx: atTxs[i]}, state)
+		if err != nil {
+			return nil, err
+		}
+
+		entries.Entries[i] = vEntry.Entry
+		state = newState
+	}
+
+	err = c.verifyStateSignature(state)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// entryReadByKey tells whether the entry was read by the given key, either directly or through a reference
+func entryReadByKey(e *schema.Entry, key []byte) bool {
+	if e.ReferencedBy != nil {
+		return bytes.Equal(e.ReferencedBy.Key, key)
+	}
+
+	return bytes.Equal(e.Key, key)
+}
+
+// entryTxID returns the transaction in which the key the entry was read by was set
+func entryTxID(e *schema.Entry) uint64 {
+	if e.ReferencedBy != nil {
+		return e.ReferencedBy.Tx
+	}
+
+	return e.Tx
+}
+
+// verifiableGet fetches the entry together with the proofs linking it to the given state,
+// it returns the state including both the given one and the entry, without saving it.
+func (c *immuClient) verifiableGet(ctx context.Context, kReq *schema.KeyRequest, state *schema.ImmutableState) (*schema.VerifiableEntry, *schema.ImmutableState, error) {
 	req := &schema.VerifiableGetRequest{
 		KeyRequest:   kReq,
 		ProveSinceTx: state.TxId,
@@ -1139,12 +1302,12 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 
 	vEntry, err := c.ServiceClient.VerifiableGet(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	entrySpecDigest, err := store.EntrySpecDigestFor(int(vEntry.VerifiableTx.Tx.Header.Version))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	inclusionProof := schema.InclusionProofFromProto(vEntry.InclusionProof)
@@ -1195,7 +1358,7 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 		entrySpecDigest(e),
 		eh)
 	if !verifies {
-		return nil, store.ErrCorruptedData
+		return nil, nil, store.ErrCorruptedData
 	}
 
 	if state.TxId > 0 {
@@ -1208,7 +1371,7 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 			targetAlh,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -1219,19 +1382,7 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 		Signature: vEntry.VerifiableTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
-	if err != nil {
-		return nil, err
-	}
-
-	return vEntry.Entry, nil
+	return vEntry, newState, nil
 }
 
 // Scan iterates over the set of keys in a topological order.
@@ -1397,11 +1548,9 @@ func (c *immuClient) VerifiedSet(ctx context.Context, key []byte, value []byte)
 		Signature: verifiableTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
@@ -1589,11 +1738,9 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 		Signature: vTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
@@ -1770,11 +1917,9 @@ func (c *immuClient) VerifiedSetReferenceAt(ctx context.Context, key []byte, ref
 		Signature: verifiableTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
@@ -1940,11 +2085,9 @@ func (c *immuClient) VerifiedZAddAt(ctx context.Context, set []byte, score float
 		Signature: vtx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
@@ -2265,6 +2408,38 @@ func (c *immuClient) ChangePermission(ctx context.Context, action schema.Permiss
 	return err
 }
 
+// ChangeCollectionPermission grants or revokes permission to one collection for given user.
+//
+// This call requires SysAdmin or admin permission to the database of the collection.
+//
+// The permission argument is used when granting permission and can be one of those values:
+//   - 1 (auth.PermissionR) - read-only access
+//   - 2 (auth.PermissionRW) - read-write access
+//
+// Once granted a permission on a collection, the user can only access the collections of
+// the database it was granted permission on, within the limits of its database permission.
+func (c *immuClient) ChangeCollectionPermission(ctx context.Context, action schema.PermissionAction, username string, database string, collection string, permission uint32) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	in := &schema.ChangeCollectionPermissionRequest{
+		Action:     action,
+		Username:   username,
+		Database:   database,
+		Collection: collection,
+		Permission: permission,
+	}
+
+	_, err := c.ServiceClient.ChangeCollectionPermission(ctx, in)
+
+	c.Logger.Debugf("ChangeCollectionPermission finished in %s", time.Since(start))
+
+	return err
+}
+
 // SetActiveUser activates or deactivates a user.
 // This call requires SysAdmin or Admin permission.
 func (c *immuClient) SetActiveUser(ctx context.Context, u *schema.SetActiveUserRequest) error {
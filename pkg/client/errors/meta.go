@@ -33,6 +33,14 @@ const (
 	CodInvalidTransactionInitiation                  Code = "0B000"
 	CodInFailedSqlTransaction                        Code = "25P02"
 	CodIntegrityConstraintViolation                  Code = "23000"
+	CodUniqueViolation                               Code = "23505"
+	CodUndefinedTable                                Code = "42P01"
+	CodDuplicateTable                                Code = "42P07"
+	CodUndefinedObject                               Code = "42704"
+	CodSyntaxError                                   Code = "42601"
+	CodNoDataFound                                   Code = "P0002"
+	CodSerializationFailure                          Code = "40001"
+	CodProgramLimitExceeded                          Code = "54000"
 
 	// Backwards compatibility
 	CodNoSessionAuthDataProvided Code = CodInvalidAuthorizationSpecification
@@ -61,6 +61,8 @@ type Options struct {
 
 	HeartBeatFrequency time.Duration // Duration between two consecutive heartbeat calls to the server for session heartbeats
 
+	ServerTimestampRoots string // Name of the PEM file containing the roots of the timestamp authorities trusted to timestamp server signatures
+
 	DisableIdentityCheck bool // Do not validate server's identity
 }
 
@@ -213,6 +215,13 @@ func (o *Options) WithServerSigningPubKey(serverSigningPubKey string) *Options {
 	return o
 }
 
+// WithServerTimestampRoots sets the PEM file of the trusted timestamp authorities roots.
+// If present, the timestamp tokens attached to server state signatures are verified
+func (o *Options) WithServerTimestampRoots(serverTimestampRoots string) *Options {
+	o.ServerTimestampRoots = serverTimestampRoots
+	return o
+}
+
 // WithStreamChunkSize set the chunk size
 func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	o.StreamChunkSize = streamChunkSize
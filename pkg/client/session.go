@@ -19,6 +19,21 @@ import (
 //
 // Note: it is important to call CloseSession() once the session is no longer needed.
 func (c *immuClient) OpenSession(ctx context.Context, user []byte, pass []byte, database string) (err error) {
+	return c.openSession(ctx, user, pass, database, "")
+}
+
+// ResumeSession opens a new connection to the server and binds it to a session previously
+// opened with the same credentials and database, e.g. after the former connection was lost.
+// Ongoing transactions of the session can still be used once the session has been resumed.
+func (c *immuClient) ResumeSession(ctx context.Context, user []byte, pass []byte, database string, sessionID string) (err error) {
+	if sessionID == "" {
+		return errors.FromError(ErrIllegalArguments)
+	}
+
+	return c.openSession(ctx, user, pass, database, sessionID)
+}
+
+func (c *immuClient) openSession(ctx context.Context, user []byte, pass []byte, database string, sessionID string) (err error) {
 	if c.IsConnected() {
 		return errors.FromError(ErrSessionAlreadyOpen)
 	}
@@ -31,6 +46,14 @@ func (c *immuClient) OpenSession(ctx context.Context, user []byte, pass []byte,
 		c.WithServerSigningPubKey(pk)
 	}
 
+	if c.Options.ServerTimestampRoots != "" {
+		roots, e := signer.ParseCertPoolFile(c.Options.ServerTimestampRoots)
+		if e != nil {
+			return e
+		}
+		c.WithServerTimestampRoots(roots)
+	}
+
 	if c.Options.StreamChunkSize < stream.MinChunkSize {
 		return errors.New(stream.ErrChunkTooSmall).WithCode(errors.CodInvalidParameterValue)
 	}
@@ -52,12 +75,14 @@ func (c *immuClient) OpenSession(ctx context.Context, user []byte, pass []byte,
 		Username:     user,
 		Password:     pass,
 		DatabaseName: database,
+		SessionID:    sessionID,
 	})
 	if err != nil {
 		return errors.FromError(err)
 	}
 	defer func() {
-		if err != nil {
+		// a resumed session is left open, so it can be resumed again
+		if err != nil && sessionID == "" {
 			_, _ = serviceClient.CloseSession(ctx, new(empty.Empty))
 		}
 	}()
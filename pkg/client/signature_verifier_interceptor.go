@@ -33,10 +33,29 @@ func (c *immuClient) SignatureVerifierInterceptor(ctx context.Context, method st
 	}
 	if method == "/immudb.schema.ImmuService/CurrentState" {
 		state := reply.(*schema.ImmutableState)
-		err := state.CheckSignature(c.serverSigningPubKey)
+		err := c.verifyStateSignature(state)
 		if err != nil {
 			return status.Errorf(codes.InvalidArgument, "unable to verify signature: %s", err)
 		}
 	}
 	return ris
 }
+
+// verifyStateSignature checks the state was signed by the server, if its public key was provided.
+// When trusted timestamp authorities are set, the timestamp token attached to the signature is verified as well.
+func (c *immuClient) verifyStateSignature(state *schema.ImmutableState) error {
+	if c.serverSigningPubKey == nil {
+		return nil
+	}
+
+	err := state.CheckSignature(c.serverSigningPubKey)
+	if err != nil {
+		return err
+	}
+
+	if c.serverTimestampRoots != nil {
+		_, err = state.CheckTimestamp(c.serverTimestampRoots)
+	}
+
+	return err
+}
@@ -272,11 +272,9 @@ func (c *immuClient) VerifyRow(ctx context.Context, row *schema.Row, table strin
 		Signature: vEntry.VerifiableTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return err
 	}
 
 	err = c.StateService.SetState(c.currentDatabase(), newState)
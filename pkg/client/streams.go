@@ -264,11 +264,9 @@ func (c *immuClient) _streamVerifiedSet(ctx context.Context, kvs []*stream.KeyVa
 		Signature: verifiableTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
@@ -378,11 +376,9 @@ func (c *immuClient) _streamVerifiedGet(ctx context.Context, req *schema.Verifia
 		Signature: vEntry.VerifiableTx.Signature,
 	}
 
-	if c.serverSigningPubKey != nil {
-		err := newState.CheckSignature(c.serverSigningPubKey)
-		if err != nil {
-			return nil, err
-		}
+	err = c.verifyStateSignature(newState)
+	if err != nil {
+		return nil, err
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
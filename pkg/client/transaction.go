@@ -41,6 +41,10 @@ type Tx interface {
 	// Such query does not return SQL result.
 	SQLExec(ctx context.Context, sql string, params map[string]interface{}) error
 
+	// SQLExecWithResult performs a modifying SQL query within the transaction
+	// and returns the number of affected rows and the last inserted primary keys.
+	SQLExecWithResult(ctx context.Context, sql string, params map[string]interface{}) (*schema.TxSQLExecResult, error)
+
 	// SQLQuery performs a query (read-only) operation.
 	//
 	// Deprecated: use SQLQueryReader instead.
@@ -48,11 +52,20 @@ type Tx interface {
 
 	// SQLQueryReader submits an SQL query to the server and returns a reader object for efficient retrieval of all rows in the result set.
 	SQLQueryReader(ctx context.Context, sql string, params map[string]interface{}) (SQLQueryRowReader, error)
+
+	// SnapshotTxID returns the ID of the latest transaction visible within the transaction.
+	// It's only known when the transaction was created using the SnapshotSinceTx option, zero is returned otherwise.
+	SnapshotTxID() uint64
 }
 
 type tx struct {
 	ic            *immuClient
 	transactionID string
+	snapshotTxID  uint64
+}
+
+func (c *tx) SnapshotTxID() uint64 {
+	return c.snapshotTxID
 }
 
 func (c *tx) Commit(ctx context.Context) (*schema.CommittedSQLTx, error) {
@@ -88,20 +101,29 @@ func (c *immuClient) NewTx(ctx context.Context, opts ...TxOption) (Tx, error) {
 	tx := &tx{
 		ic:            c,
 		transactionID: r.TransactionID,
+		snapshotTxID:  r.SnapshotTxID,
 	}
 	return tx, nil
 }
 
 func (c *tx) SQLExec(ctx context.Context, sql string, params map[string]interface{}) error {
+	_, err := c.SQLExecWithResult(ctx, sql, params)
+	return err
+}
+
+func (c *tx) SQLExecWithResult(ctx context.Context, sql string, params map[string]interface{}) (*schema.TxSQLExecResult, error) {
 	namedParams, err := schema.EncodeParams(params)
 	if err != nil {
-		return errors.FromError(err)
+		return nil, errors.FromError(err)
 	}
-	_, err = c.ic.ServiceClient.TxSQLExec(c.populateCtx(ctx), &schema.SQLExecRequest{
+	res, err := c.ic.ServiceClient.TxSQLExec(c.populateCtx(ctx), &schema.SQLExecRequest{
 		Sql:    sql,
 		Params: namedParams,
 	})
-	return errors.FromError(err)
+	if err != nil {
+		return nil, errors.FromError(err)
+	}
+	return res, nil
 }
 
 func (c *tx) SQLQuery(ctx context.Context, sql string, params map[string]interface{}) (*schema.SQLQueryResult, error) {
@@ -48,6 +48,16 @@ func SnapshotMustIncludeTxID(txID uint64) TxOption {
 	}
 }
 
+// SnapshotSinceTx option guarantees the transaction observes at least the specified committed transaction,
+// e.g. one just written using a different transaction. The server waits for a limited amount
+// of time for the transaction to be indexed.
+func SnapshotSinceTx(txID uint64) TxOption {
+	return func(req *schema.NewTxRequest) error {
+		req.SinceTx = txID
+		return nil
+	}
+}
+
 // An existing snapshot may be reused as long as it is not older than the specified timeframe
 func SnapshotRenewalPeriod(renewalPeriod time.Duration) TxOption {
 	return func(req *schema.NewTxRequest) error {
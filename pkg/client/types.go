@@ -18,6 +18,7 @@ package client
 
 import (
 	"crypto/ecdsa"
+	"crypto/x509"
 
 	"github.com/codenotary/immudb/pkg/client/tokenservice"
 	"github.com/codenotary/immudb/pkg/stream"
@@ -64,6 +65,13 @@ func (c *immuClient) WithServerSigningPubKey(publicKey *ecdsa.PublicKey) *immuCl
 	return c
 }
 
+// WithServerTimestampRoots sets up the trusted timestamp authorities roots, when set the
+// timestamp tokens attached to the server state signatures are verified.
+func (c *immuClient) WithServerTimestampRoots(roots *x509.CertPool) *immuClient {
+	c.serverTimestampRoots = roots
+	return c
+}
+
 // WithStreamServiceFactory sets up stream factory for the client.
 func (c *immuClient) WithStreamServiceFactory(ssf stream.ServiceFactory) *immuClient {
 	c.StreamServiceFactory = ssf
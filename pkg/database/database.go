@@ -745,8 +745,9 @@ func (d *db) Health() (waitingCount int, lastReleaseAt time.Time) {
 
 // CurrentState ...
 func (d *db) CurrentState() (*schema.ImmutableState, error) {
-	lastTxID, lastTxAlh := d.st.CommittedAlh()
-	lastPreTxID, lastPreTxAlh := d.st.PrecommittedAlh()
+	// committed and precommitted states are captured at once, the signed
+	// (txId, txHash) pair always corresponds to the same committed transaction
+	lastTxID, lastTxAlh, lastPreTxID, lastPreTxAlh := d.st.CommitState()
 
 	return &schema.ImmutableState{
 		TxId:               lastTxID,
@@ -17,7 +17,9 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/store"
@@ -25,10 +27,18 @@ import (
 	"github.com/codenotary/immudb/pkg/api/schema"
 )
 
+// MaxDocumentsSnapshotWait is the maximum amount of time to wait for the transaction
+// documents are requested to be read at to be committed
+const MaxDocumentsSnapshotWait = 5 * time.Second
+
+var ErrTxNotCommitted = errors.New("transaction not committed")
+
 // DocumentDatabase is the interface for document database
 type DocumentDatabase interface {
 	// GetCollection returns the collection schema
 	GetCollection(ctx context.Context, req *protomodel.GetCollectionRequest) (*protomodel.GetCollectionResponse, error)
+	// GetCollectionStats returns the document count and index statistics of a collection
+	GetCollectionStats(ctx context.Context, req *protomodel.GetCollectionStatsRequest) (*protomodel.GetCollectionStatsResponse, error)
 	// GetCollections returns the list of collection schemas
 	GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error)
 	// CreateCollection creates a new collection
@@ -51,14 +61,25 @@ type DocumentDatabase interface {
 	ReplaceDocuments(ctx context.Context, username string, req *protomodel.ReplaceDocumentsRequest) (*protomodel.ReplaceDocumentsResponse, error)
 	// AuditDocument returns the document audit history
 	AuditDocument(ctx context.Context, req *protomodel.AuditDocumentRequest) (*protomodel.AuditDocumentResponse, error)
-	// SearchDocuments returns the documents matching the query
-	SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error)
+	// DocumentsSnapshotTx returns the transaction documents are read at, waiting for it to be committed if needed
+	DocumentsSnapshotTx(ctx context.Context, atTx uint64) (uint64, error)
+	// SearchDocuments returns the documents matching the query as of the atTx transaction
+	SearchDocuments(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64) (document.DocumentReader, error)
+	// SearchDocumentsAfter returns the documents matching the query sorted after the cursor as of the atTx transaction
+	SearchDocumentsAfter(ctx context.Context, query *protomodel.Query, atTx uint64, cursor *document.Cursor) (document.DocumentReader, error)
+	// ExplainDocuments returns the plan followed to resolve the query as of the atTx transaction
+	// and whether it requires scanning all the documents of the collection
+	ExplainDocuments(ctx context.Context, query *protomodel.Query, atTx uint64) (*protomodel.QueryPlan, bool, error)
+	// NewDocumentCursor returns the cursor pointing to a document read using the query
+	NewDocumentCursor(query *protomodel.Query, rev *protomodel.DocumentAtRevision) (*document.Cursor, error)
 	// CountDocuments returns the number of documents matching the query
 	CountDocuments(ctx context.Context, req *protomodel.CountDocumentsRequest) (*protomodel.CountDocumentsResponse, error)
 	// DeleteDocuments deletes documents maching the query
 	DeleteDocuments(ctx context.Context, username string, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error)
 	// ProofDocument returns the proofs for a document
 	ProofDocument(ctx context.Context, req *protomodel.ProofDocumentRequest) (*protomodel.ProofDocumentResponse, error)
+	// DeleteExpiredDocuments deletes the documents whose expiration time has been reached
+	DeleteExpiredDocuments(ctx context.Context) error
 }
 
 // CreateCollection creates a new collection
@@ -74,7 +95,11 @@ func (d *db) CreateCollection(ctx context.Context, username string, req *protomo
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.CreateCollection(ctx, username, req.Name, req.DocumentIdFieldName, req.Fields, req.Indexes)
+	err := d.documentEngine.CreateCollectionWithOptions(ctx, username, req.Name, req.DocumentIdFieldName, req.Fields, req.Indexes, &document.CollectionOptions{
+		TTL:          req.Ttl,
+		StrictSchema: req.StrictSchema,
+		MaxDocuments: req.MaxDocuments,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +121,20 @@ func (d *db) GetCollection(ctx context.Context, req *protomodel.GetCollectionReq
 	return &protomodel.GetCollectionResponse{Collection: cinfo}, nil
 }
 
+// GetCollectionStats returns the document count and index statistics of a collection
+func (d *db) GetCollectionStats(ctx context.Context, req *protomodel.GetCollectionStatsRequest) (*protomodel.GetCollectionStatsResponse, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	stats, err := d.documentEngine.GetCollectionStats(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protomodel.GetCollectionStatsResponse{Stats: stats}, nil
+}
+
 func (d *db) GetCollections(ctx context.Context, _ *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
 	collections, err := d.documentEngine.GetCollections(ctx)
 	if err != nil {
@@ -202,7 +241,7 @@ func (d *db) CreateIndex(ctx context.Context, username string, req *protomodel.C
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.CreateIndex(ctx, username, req.CollectionName, req.Fields, req.IsUnique)
+	err := d.documentEngine.CreateIndexWithCollation(ctx, username, req.CollectionName, req.Fields, req.IsUnique, req.Collation)
 	if err != nil {
 		return nil, err
 	}
@@ -316,9 +355,55 @@ func (d *db) AuditDocument(ctx context.Context, req *protomodel.AuditDocumentReq
 	}, nil
 }
 
-// SearchDocuments returns the documents matching the search request constraints
-func (d *db) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
-	return d.documentEngine.GetDocuments(ctx, query, offset)
+// DocumentsSnapshotTx returns the transaction documents are read at. The latest committed
+// transaction is returned when atTx is zero, otherwise it waits for atTx to be committed
+// for up to MaxDocumentsSnapshotWait
+func (d *db) DocumentsSnapshotTx(ctx context.Context, atTx uint64) (uint64, error) {
+	committedTxID, _ := d.st.CommittedAlh()
+
+	if atTx == 0 {
+		return committedTxID, nil
+	}
+
+	if atTx <= committedTxID {
+		return atTx, nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, MaxDocumentsSnapshotWait)
+	defer cancel()
+
+	err := d.st.WaitForTx(waitCtx, atTx, false)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return 0, fmt.Errorf("%w: transaction %d was not committed within %s", ErrTxNotCommitted, atTx, MaxDocumentsSnapshotWait)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return atTx, nil
+}
+
+// SearchDocuments returns the documents matching the search request constraints,
+// as they were once the atTx transaction was committed
+func (d *db) SearchDocuments(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64) (document.DocumentReader, error) {
+	return d.documentEngine.GetDocumentsAtTx(ctx, query, atTx, offset)
+}
+
+// SearchDocumentsAfter returns the documents matching the query sorted after the cursor,
+// as they were once the atTx transaction was committed
+func (d *db) SearchDocumentsAfter(ctx context.Context, query *protomodel.Query, atTx uint64, cursor *document.Cursor) (document.DocumentReader, error) {
+	return d.documentEngine.GetDocumentsAfterAtTx(ctx, query, atTx, cursor)
+}
+
+// ExplainDocuments runs the query as of the atTx transaction and returns the plan followed to resolve it,
+// matching documents are not returned
+func (d *db) ExplainDocuments(ctx context.Context, query *protomodel.Query, atTx uint64) (*protomodel.QueryPlan, bool, error) {
+	return d.documentEngine.ExplainDocuments(ctx, query, atTx)
+}
+
+// NewDocumentCursor returns the cursor pointing to a document read using the query
+func (d *db) NewDocumentCursor(query *protomodel.Query, rev *protomodel.DocumentAtRevision) (*document.Cursor, error) {
+	return d.documentEngine.NewCursor(query, rev)
 }
 
 // CountDocuments returns the number of documents matching the query
@@ -353,6 +438,14 @@ func (d *db) DeleteDocuments(ctx context.Context, username string, req *protomod
 	return &protomodel.DeleteDocumentsResponse{}, nil
 }
 
+func (d *db) DeleteExpiredDocuments(ctx context.Context) error {
+	if d.isReplica() {
+		return ErrIsReplica
+	}
+
+	return d.documentEngine.DeleteExpiredDocuments(ctx)
+}
+
 // ProofDocument returns the proofs for a documenta
 func (d *db) ProofDocument(ctx context.Context, req *protomodel.ProofDocumentRequest) (*protomodel.ProofDocumentResponse, error) {
 	if req == nil {
@@ -371,6 +371,45 @@ func TestDocumentDB_WithCollections(t *testing.T) {
 	})
 }
 
+func TestDocumentDB_WithStrictSchema(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	_, err := db.CreateCollection(context.Background(), "admin", &protomodel.CreateCollectionRequest{
+		Name: "strictcollection",
+		Fields: []*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+		},
+		StrictSchema: true,
+	})
+	require.NoError(t, err)
+
+	cinfo, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{
+		Name: "strictcollection",
+	})
+	require.NoError(t, err)
+	require.True(t, cinfo.Collection.StrictSchema)
+
+	_, err = db.InsertDocuments(context.Background(), "admin", &protomodel.InsertDocumentsRequest{
+		CollectionName: "strictcollection",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("alice"),
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = db.InsertDocuments(context.Background(), "admin", &protomodel.InsertDocumentsRequest{
+		CollectionName: "strictcollection",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{
+				"nmae": structpb.NewStringValue("alice"),
+			}},
+		},
+	})
+	require.ErrorIs(t, err, document.ErrSchemaViolation)
+}
+
 func TestDocumentDB_WithDocuments(t *testing.T) {
 	db := makeDocumentDb(t)
 
@@ -452,6 +491,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 			},
 		},
 			0,
+			0,
 		)
 		require.NoError(t, err)
 
@@ -526,6 +566,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 			},
 		},
 			0,
+			0,
 		)
 		require.NoError(t, err)
 
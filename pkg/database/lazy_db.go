@@ -555,6 +555,17 @@ func (db *lazyDB) GetCollection(ctx context.Context, req *protomodel.GetCollecti
 	return d.GetCollection(ctx, req)
 }
 
+// GetCollectionStats returns the document count and index statistics of a collection
+func (db *lazyDB) GetCollectionStats(ctx context.Context, req *protomodel.GetCollectionStatsRequest) (*protomodel.GetCollectionStatsResponse, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.GetCollectionStats(ctx, req)
+}
+
 func (db *lazyDB) GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -655,14 +666,54 @@ func (db *lazyDB) AuditDocument(ctx context.Context, req *protomodel.AuditDocume
 	return d.AuditDocument(ctx, req)
 }
 
-func (db *lazyDB) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
+func (db *lazyDB) DocumentsSnapshotTx(ctx context.Context, atTx uint64) (uint64, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return 0, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.DocumentsSnapshotTx(ctx, atTx)
+}
+
+func (db *lazyDB) SearchDocuments(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64) (document.DocumentReader, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.SearchDocuments(ctx, query, atTx, offset)
+}
+
+func (db *lazyDB) SearchDocumentsAfter(ctx context.Context, query *protomodel.Query, atTx uint64, cursor *document.Cursor) (document.DocumentReader, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
 		return nil, err
 	}
 	defer db.m.Release(db.idx)
 
-	return d.SearchDocuments(ctx, query, offset)
+	return d.SearchDocumentsAfter(ctx, query, atTx, cursor)
+}
+
+func (db *lazyDB) ExplainDocuments(ctx context.Context, query *protomodel.Query, atTx uint64) (*protomodel.QueryPlan, bool, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.ExplainDocuments(ctx, query, atTx)
+}
+
+func (db *lazyDB) NewDocumentCursor(query *protomodel.Query, rev *protomodel.DocumentAtRevision) (*document.Cursor, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.NewDocumentCursor(query, rev)
 }
 
 func (db *lazyDB) CountDocuments(ctx context.Context, req *protomodel.CountDocumentsRequest) (*protomodel.CountDocumentsResponse, error) {
@@ -695,6 +746,22 @@ func (db *lazyDB) DeleteDocuments(ctx context.Context, username string, req *pro
 	return d.DeleteDocuments(ctx, username, req)
 }
 
+// DeleteExpiredDocuments deletes the expired documents only if the database is loaded,
+// expired documents are anyway excluded from queries
+func (db *lazyDB) DeleteExpiredDocuments(ctx context.Context) error {
+	if !db.m.IsActive(db.idx) {
+		return nil
+	}
+
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.DeleteExpiredDocuments(ctx)
+}
+
 func (db *lazyDB) FindTruncationPoint(ctx context.Context, until time.Time) (*schema.TxHeader, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
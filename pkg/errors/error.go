@@ -81,6 +81,7 @@ type immuError struct {
 	msg        string
 	stack      string
 	retryDelay int32
+	wrapped    error
 }
 
 func (f *immuError) Error() string {
@@ -117,6 +118,17 @@ func (e *immuError) WithRetryDelay(delay int32) *immuError {
 	return e
 }
 
+// WithWrapped keeps the error that originated this one in its chain, so it can still be
+// matched with errors.Is and errors.As
+func (e *immuError) WithWrapped(err error) *immuError {
+	e.wrapped = err
+	return e
+}
+
+func (e *immuError) Unwrap() error {
+	return e.wrapped
+}
+
 func (e *immuError) Is(target error) bool {
 	switch t := target.(type) {
 	case *immuError:
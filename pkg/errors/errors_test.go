@@ -132,6 +132,17 @@ func Test_CauseComparisonError(t *testing.T) {
 	require.True(t, stdErrors.Is(err2, err3))
 }
 
+func Test_WithWrapped(t *testing.T) {
+	errStd := stdErrors.New("std error")
+	err := errors.New("immu error: std error").WithCode(errors.CodSerializationFailure).WithRetryDelay(123).WithWrapped(errStd)
+
+	require.True(t, stdErrors.Is(err, errStd))
+	require.True(t, stdErrors.Is(err, errors.New("another error").WithCode(errors.CodSerializationFailure)))
+	require.Equal(t, errors.CodSerializationFailure, err.Code())
+	require.Equal(t, int32(123), err.RetryDelay())
+	require.Equal(t, "immu error: std error", err.Error())
+}
+
 func Test_WrappingImmuerrorWithKnowCode(t *testing.T) {
 	t.Setenv("LOG_LEVEL", "debug")
 
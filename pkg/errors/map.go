@@ -38,6 +38,16 @@ func mapGRPcErrorCode(code Code) codes.Code {
 		return codes.NotFound
 	case CodIntegrityConstraintViolation:
 		return codes.FailedPrecondition
+	case CodUniqueViolation, CodDuplicateTable:
+		return codes.AlreadyExists
+	case CodUndefinedTable, CodUndefinedObject, CodNoDataFound:
+		return codes.NotFound
+	case CodSyntaxError:
+		return codes.InvalidArgument
+	case CodSerializationFailure:
+		return codes.Aborted
+	case CodProgramLimitExceeded:
+		return codes.ResourceExhausted
 	default:
 		return codes.Unknown
 	}
@@ -36,6 +36,22 @@ func Test_Map(t *testing.T) {
 	require.Equal(t, codes.Internal, st)
 	st = mapGRPcErrorCode(CodUndefinedFunction)
 	require.Equal(t, codes.Unimplemented, st)
+	st = mapGRPcErrorCode(CodUniqueViolation)
+	require.Equal(t, codes.AlreadyExists, st)
+	st = mapGRPcErrorCode(CodDuplicateTable)
+	require.Equal(t, codes.AlreadyExists, st)
+	st = mapGRPcErrorCode(CodUndefinedTable)
+	require.Equal(t, codes.NotFound, st)
+	st = mapGRPcErrorCode(CodUndefinedObject)
+	require.Equal(t, codes.NotFound, st)
+	st = mapGRPcErrorCode(CodNoDataFound)
+	require.Equal(t, codes.NotFound, st)
+	st = mapGRPcErrorCode(CodSyntaxError)
+	require.Equal(t, codes.InvalidArgument, st)
+	st = mapGRPcErrorCode(CodSerializationFailure)
+	require.Equal(t, codes.Aborted, st)
+	st = mapGRPcErrorCode(CodProgramLimitExceeded)
+	require.Equal(t, codes.ResourceExhausted, st)
 	st = mapGRPcErrorCode(Code("Unknown"))
 	require.Equal(t, codes.Unknown, st)
 }
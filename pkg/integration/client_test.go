@@ -17,6 +17,7 @@ limitations under the License.
 package integration
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"os"
@@ -822,6 +823,36 @@ func TestUserManagement(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	err = client.ChangeCollectionPermission(
+		ctx,
+		schema.PermissionAction_GRANT,
+		userName,
+		testDBName,
+		"mycollection",
+		auth.PermissionR,
+	)
+	require.NoError(t, err)
+
+	usrList, err = client.ListUsers(ctx)
+	require.NoError(t, err)
+
+	for _, usr := range usrList.Users {
+		if string(usr.User) == userName {
+			require.Len(t, usr.CollectionPermissions, 1)
+			require.Equal(t, "mycollection", usr.CollectionPermissions[0].Collection)
+		}
+	}
+
+	err = client.ChangeCollectionPermission(
+		ctx,
+		schema.PermissionAction_REVOKE,
+		userName,
+		testDBName,
+		"mycollection",
+		0,
+	)
+	require.NoError(t, err)
+
 	err = client.ChangePermission(
 		ctx,
 		schema.PermissionAction_REVOKE,
@@ -976,6 +1007,68 @@ func TestImmuClient_GetAll(t *testing.T) {
 	require.ErrorContains(t, err, "database is reserved")
 }
 
+func TestImmuClient_VerifiedGetAll(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.VerifiedGetAll(ctx, nil)
+	require.ErrorIs(t, err, ic.ErrIllegalArguments)
+
+	_, err = client.VerifiedSet(ctx, []byte(`aaa`), []byte(`val1`))
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte(`bbb`), []byte(`val2`))
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte(`aaa`), []byte(`val3`))
+	require.NoError(t, err)
+
+	entries, err := client.VerifiedGetAll(ctx, [][]byte{[]byte(`bbb`), []byte(`aaa`)})
+	require.NoError(t, err)
+	require.Len(t, entries.Entries, 2)
+	require.Equal(t, []byte(`bbb`), entries.Entries[0].Key)
+	require.Equal(t, []byte(`val2`), entries.Entries[0].Value)
+	require.Equal(t, []byte(`aaa`), entries.Entries[1].Key)
+	require.Equal(t, []byte(`val3`), entries.Entries[1].Value)
+
+	_, err = client.VerifiedGetAll(ctx, [][]byte{[]byte(`aaa`), []byte(`ccc`)})
+	require.ErrorContains(t, err, "key not found")
+
+	bs.Server.PreVerifiableGetFn = func(ctx context.Context, req *schema.VerifiableGetRequest) {
+		if bytes.Equal(req.KeyRequest.Key, []byte(`bbb`)) {
+			req.KeyRequest.Key = []byte(`aaa`)
+			req.KeyRequest.AtTx = 0
+		}
+	}
+
+	_, err = client.VerifiedGetAll(ctx, [][]byte{[]byte(`aaa`), []byte(`bbb`)})
+	require.ErrorIs(t, err, store.ErrCorruptedData)
+
+	// entries are read from the same snapshot even if keys are updated in the meantime
+	written := false
+	bs.Server.PreVerifiableGetFn = func(ctx context.Context, req *schema.VerifiableGetRequest) {
+		if !written {
+			written = true
+
+			_, err := client.Set(context.Background(), []byte(`bbb`), []byte(`val4`))
+			require.NoError(t, err)
+		}
+	}
+
+	entries, err = client.VerifiedGetAll(ctx, [][]byte{[]byte(`aaa`), []byte(`bbb`)})
+	require.NoError(t, err)
+	require.True(t, written)
+	require.Len(t, entries.Entries, 2)
+	require.Equal(t, []byte(`val3`), entries.Entries[0].Value)
+	require.Equal(t, []byte(`val2`), entries.Entries[1].Value)
+
+	bs.Server.PreVerifiableGetFn = nil
+
+	entries, err = client.VerifiedGetAll(ctx, [][]byte{[]byte(`aaa`), []byte(`bbb`)})
+	require.NoError(t, err)
+	require.Len(t, entries.Entries, 2)
+	require.Equal(t, []byte(`val4`), entries.Entries[1].Value)
+}
+
 func TestImmuClient_Delete(t *testing.T) {
 	_, client, ctx := setupTestServerAndClient(t)
 
@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -124,6 +125,57 @@ func TestSession_OpenCloseSessionMulti(t *testing.T) {
 	require.Equal(t, 0, bs.Server.Srv.SessManager.SessionCount())
 }
 
+func TestSession_ResumeSession(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.SQLExec(ctx, "CREATE TABLE mytable(id INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	tx, err := client.NewTx(ctx)
+	require.NoError(t, err)
+
+	err = tx.SQLExec(ctx, "INSERT INTO mytable(id) VALUES (1)", nil)
+	require.NoError(t, err)
+
+	sessionID := client.GetSessionID()
+
+	// a client reconnecting after the former connection was lost
+	resumedClient := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+	err = resumedClient.ResumeSession(ctx, []byte(`immudb`), []byte(`immudb`), "defaultdb", "")
+	require.ErrorIs(t, err, ic.ErrIllegalArguments)
+
+	err = resumedClient.ResumeSession(ctx, []byte(`immudb`), []byte(`wrong`), "defaultdb", sessionID)
+	require.Error(t, err)
+
+	err = resumedClient.ResumeSession(ctx, []byte(`immudb`), []byte(`immudb`), "defaultdb", "unknown")
+	require.ErrorContains(t, err, sessions.ErrSessionNotFound.Error())
+
+	err = resumedClient.ResumeSession(ctx, []byte(`immudb`), []byte(`immudb`), "defaultdb", sessionID)
+	require.NoError(t, err)
+	require.Equal(t, sessionID, resumedClient.GetSessionID())
+
+	// the transaction opened before the reconnection is still usable
+	txCtx := metadata.AppendToOutgoingContext(ctx, "transactionid", tx.(interface{ GetTransactionID() string }).GetTransactionID())
+
+	_, err = resumedClient.GetServiceClient().TxSQLExec(txCtx, &schema.SQLExecRequest{Sql: "INSERT INTO mytable(id) VALUES (2)"})
+	require.NoError(t, err)
+
+	_, err = resumedClient.GetServiceClient().Commit(txCtx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	res, err := resumedClient.SQLQuery(ctx, "SELECT COUNT(*) FROM mytable", nil, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Rows[0].Values[0].GetN())
+
+	err = resumedClient.CloseSession(ctx)
+	require.NoError(t, err)
+
+	err = bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir())).
+		ResumeSession(ctx, []byte(`immudb`), []byte(`immudb`), "defaultdb", sessionID)
+	require.ErrorContains(t, err, sessions.ErrSessionNotFound.Error())
+}
+
 func TestSession_OpenSessionNotConnected(t *testing.T) {
 	client := ic.NewClient()
 	err := client.CloseSession(context.Background())
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	immudb "github.com/codenotary/immudb/pkg/client"
@@ -93,6 +94,38 @@ func TestTransaction_SetAndGet(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTransaction_SQLExecWithResult(t *testing.T) {
+	_, client := setupTest(t, -1)
+
+	_, err := client.SQLExec(context.Background(), `CREATE TABLE table1(
+		id INTEGER AUTO_INCREMENT,
+		title VARCHAR,
+		PRIMARY KEY id
+		);`, nil)
+	require.NoError(t, err)
+
+	tx, err := client.NewTx(context.Background())
+	require.NoError(t, err)
+
+	res, err := tx.SQLExecWithResult(context.Background(), "INSERT INTO table1(title) VALUES ('title1'), ('title2')", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res.UpdatedRows)
+	require.Len(t, res.LastInsertedPKs, 1)
+	require.Equal(t, int64(2), res.LastInsertedPKs["table1"].GetN())
+
+	res, err = tx.SQLExecWithResult(context.Background(), "UPDATE table1 SET title = @title", map[string]interface{}{"title": "title"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res.UpdatedRows)
+	require.Empty(t, res.LastInsertedPKs)
+
+	res, err = tx.SQLExecWithResult(context.Background(), "DELETE FROM table1 WHERE id = 1", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.UpdatedRows)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+}
+
 func TestTransaction_SQLReader(t *testing.T) {
 	_, client := setupTest(t, 2)
 
@@ -137,6 +170,46 @@ func TestTransaction_SQLReader(t *testing.T) {
 	require.Equal(t, 10, n)
 }
 
+func TestTransaction_SnapshotSinceTx(t *testing.T) {
+	_, client := setupTest(t, -1)
+
+	_, err := client.SQLExec(context.Background(), "CREATE TABLE table1(id INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	res, err := client.SQLExec(context.Background(), "INSERT INTO table1(id) VALUES (1)", nil)
+	require.NoError(t, err)
+
+	txID := res.Txs[0].Header.Id
+
+	tx, err := client.NewTx(context.Background(), immudb.SnapshotSinceTx(txID))
+	require.NoError(t, err)
+
+	require.GreaterOrEqual(t, tx.SnapshotTxID(), txID)
+
+	qres, err := tx.SQLQuery(context.Background(), "SELECT id FROM table1", nil)
+	require.NoError(t, err)
+	require.Len(t, qres.Rows, 1)
+
+	err = tx.Rollback(context.Background())
+	require.NoError(t, err)
+
+	t.Run("the snapshot is only known when requested", func(t *testing.T) {
+		tx, err := client.NewTx(context.Background())
+		require.NoError(t, err)
+		defer tx.Rollback(context.Background())
+
+		require.Zero(t, tx.SnapshotTxID())
+	})
+
+	t.Run("new transactions should wait for the sinceTx transaction", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := client.NewTx(ctx, immudb.SnapshotSinceTx(txID+1))
+		require.Error(t, err)
+	})
+}
+
 func TestTransaction_Rollback(t *testing.T) {
 	_, client := setupTest(t, -1)
 
@@ -303,8 +376,8 @@ func TestTransaction_MultiNoErr(t *testing.T) {
 	err = tx.SQLExec(updateStmt(1, 10))
 	require.NoError(t, err)
 	_, err = tx.Commit(ctx)
-	require.EqualError(t, err, "tx read conflict")
-	require.Equal(t, err.(errors.ImmuError).Code(), errors.CodInFailedSqlTransaction)
+	require.EqualError(t, err, "transaction conflict: tx read conflict")
+	require.Equal(t, err.(errors.ImmuError).Code(), errors.CodSerializationFailure)
 
 	txn, err := client.NewTx(ctx)
 	require.NoError(t, err)
@@ -381,7 +454,7 @@ func TestTransaction_HandlingReadConflict(t *testing.T) {
 	err = tx.SQLExec(updateStmt(1, 10))
 	require.NoError(t, err)
 	_, err = tx.Commit(ctx)
-	require.EqualError(t, err, "tx read conflict")
+	require.EqualError(t, err, "transaction conflict: tx read conflict")
 
 	err = client.CloseSession(ctx)
 	require.NoError(t, err)
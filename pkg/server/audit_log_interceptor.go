@@ -18,11 +18,16 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 	"time"
 
+	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/audit"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // AuditLogInterceptor captures structured audit events for unary gRPC calls.
@@ -33,7 +38,11 @@ func (s *ImmuServer) AuditLogInterceptor(ctx context.Context, req interface{}, i
 
 	start := time.Now()
 	res, err := handler(ctx, req)
-	s.auditLogger.Log(s.buildAuditEvent(ctx, info.FullMethod, time.Since(start), err))
+
+	event := s.buildAuditEvent(ctx, info.FullMethod, time.Since(start), err)
+	addDocumentAuditDetails(event, req, res, s.Options.AuditLogDocumentPayloads)
+
+	s.auditLogger.Log(event)
 	return res, err
 }
 
@@ -75,3 +84,98 @@ func (s *ImmuServer) buildAuditEvent(ctx context.Context, method string, duratio
 
 	return event
 }
+
+// addDocumentAuditDetails records the collection, transaction and documents involved in a document operation.
+// Documents are only included when payloads are enabled, otherwise just their ids and field names are recorded.
+func addDocumentAuditDetails(event *audit.AuditEvent, req, res interface{}, includePayloads bool) {
+	switch req := req.(type) {
+	case *protomodel.CreateCollectionRequest:
+		event.Collection = req.Name
+
+		for _, field := range req.Fields {
+			event.Fields = append(event.Fields, field.Name)
+		}
+	case *protomodel.DeleteCollectionRequest:
+		event.Collection = req.Name
+	case *protomodel.InsertDocumentsRequest:
+		event.Collection = req.CollectionName
+		event.Fields = documentFieldNames(req.Documents...)
+
+		if includePayloads {
+			event.Documents = documentPayloads(req.Documents...)
+		}
+
+		if res, ok := res.(*protomodel.InsertDocumentsResponse); ok {
+			event.TxID = res.TransactionId
+			event.DocumentIDs = res.DocumentIds
+		}
+	case *protomodel.ReplaceDocumentsRequest:
+		event.Collection = req.GetQuery().GetCollectionName()
+		event.Fields = documentFieldNames(req.Document)
+
+		if includePayloads {
+			event.Documents = documentPayloads(req.Document)
+		}
+
+		if res, ok := res.(*protomodel.ReplaceDocumentsResponse); ok {
+			for _, rev := range res.Revisions {
+				event.DocumentIDs = append(event.DocumentIDs, rev.DocumentId)
+				event.TxID = rev.TransactionId
+			}
+		}
+	case *protomodel.DeleteDocumentsRequest:
+		event.Collection = req.GetQuery().GetCollectionName()
+	case *protomodel.SearchDocumentsRequest:
+		event.Collection = req.GetQuery().GetCollectionName()
+
+		if res, ok := res.(*protomodel.SearchDocumentsResponse); ok {
+			// documents are read at the snapshot of the search
+			event.TxID = res.AtTx
+
+			for _, rev := range res.Revisions {
+				event.DocumentIDs = append(event.DocumentIDs, rev.DocumentId)
+			}
+		}
+	}
+}
+
+// documentFieldNames returns the sorted names of the top-level fields of the documents
+func documentFieldNames(docs ...*structpb.Struct) []string {
+	names := make(map[string]struct{})
+
+	for _, doc := range docs {
+		for name := range doc.GetFields() {
+			names[name] = struct{}{}
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(names))
+	for name := range names {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+func documentPayloads(docs ...*structpb.Struct) []json.RawMessage {
+	payloads := make([]json.RawMessage, 0, len(docs))
+
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+
+		bs, err := protojson.Marshal(doc)
+		if err != nil {
+			continue
+		}
+		payloads = append(payloads, bs)
+	}
+
+	return payloads
+}
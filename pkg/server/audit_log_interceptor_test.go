@@ -0,0 +1,124 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/codenotary/immudb/pkg/audit"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestAddDocumentAuditDetails(t *testing.T) {
+	doc, err := structpb.NewStruct(map[string]interface{}{"name": "alice", "age": 30})
+	require.NoError(t, err)
+
+	t.Run("create collection", func(t *testing.T) {
+		event := &audit.AuditEvent{}
+
+		addDocumentAuditDetails(event, &protomodel.CreateCollectionRequest{
+			Name:   "users",
+			Fields: []*protomodel.Field{{Name: "name"}, {Name: "age"}},
+		}, &protomodel.CreateCollectionResponse{}, false)
+
+		require.Equal(t, "users", event.Collection)
+		require.Equal(t, []string{"name", "age"}, event.Fields)
+	})
+
+	t.Run("insert documents", func(t *testing.T) {
+		req := &protomodel.InsertDocumentsRequest{CollectionName: "users", Documents: []*structpb.Struct{doc}}
+		res := &protomodel.InsertDocumentsResponse{TransactionId: 3, DocumentIds: []string{"doc1"}}
+
+		event := &audit.AuditEvent{}
+		addDocumentAuditDetails(event, req, res, false)
+
+		require.Equal(t, "users", event.Collection)
+		require.Equal(t, uint64(3), event.TxID)
+		require.Equal(t, []string{"doc1"}, event.DocumentIDs)
+		require.Equal(t, []string{"age", "name"}, event.Fields)
+		require.Empty(t, event.Documents)
+
+		bs, err := json.Marshal(event)
+		require.NoError(t, err)
+		require.NotContains(t, string(bs), "alice")
+
+		event = &audit.AuditEvent{}
+		addDocumentAuditDetails(event, req, res, true)
+
+		require.Len(t, event.Documents, 1)
+		require.JSONEq(t, `{"name":"alice","age":30}`, string(event.Documents[0]))
+	})
+
+	t.Run("failed insertion", func(t *testing.T) {
+		event := &audit.AuditEvent{}
+		addDocumentAuditDetails(event, &protomodel.InsertDocumentsRequest{CollectionName: "users"}, nil, false)
+
+		require.Equal(t, "users", event.Collection)
+		require.Zero(t, event.TxID)
+		require.Empty(t, event.DocumentIDs)
+	})
+
+	t.Run("replace documents", func(t *testing.T) {
+		event := &audit.AuditEvent{}
+
+		addDocumentAuditDetails(event, &protomodel.ReplaceDocumentsRequest{
+			Query:    &protomodel.Query{CollectionName: "users"},
+			Document: doc,
+		}, &protomodel.ReplaceDocumentsResponse{
+			Revisions: []*protomodel.DocumentAtRevision{{TransactionId: 5, DocumentId: "doc1", Revision: 2}},
+		}, false)
+
+		require.Equal(t, "users", event.Collection)
+		require.Equal(t, uint64(5), event.TxID)
+		require.Equal(t, []string{"doc1"}, event.DocumentIDs)
+		require.Equal(t, []string{"age", "name"}, event.Fields)
+		require.Empty(t, event.Documents)
+	})
+
+	t.Run("search documents", func(t *testing.T) {
+		event := &audit.AuditEvent{}
+
+		addDocumentAuditDetails(event, &protomodel.SearchDocumentsRequest{
+			Query: &protomodel.Query{CollectionName: "users"},
+		}, &protomodel.SearchDocumentsResponse{
+			AtTx:      7,
+			Revisions: []*protomodel.DocumentAtRevision{{TransactionId: 5, DocumentId: "doc1", Document: doc}},
+		}, true)
+
+		require.Equal(t, "users", event.Collection)
+		require.Equal(t, uint64(7), event.TxID)
+		require.Equal(t, []string{"doc1"}, event.DocumentIDs)
+		require.Empty(t, event.Documents)
+	})
+
+	t.Run("delete collection", func(t *testing.T) {
+		event := &audit.AuditEvent{}
+		addDocumentAuditDetails(event, &protomodel.DeleteCollectionRequest{Name: "users"}, &protomodel.DeleteCollectionResponse{}, false)
+
+		require.Equal(t, "users", event.Collection)
+	})
+
+	t.Run("other operations", func(t *testing.T) {
+		event := &audit.AuditEvent{}
+		addDocumentAuditDetails(event, &protomodel.GetCollectionsRequest{}, nil, true)
+
+		require.Equal(t, &audit.AuditEvent{}, event)
+	})
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/server/sessions"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -37,6 +38,7 @@ func (s *authenticationServiceImp) OpenSession(ctx context.Context, loginReq *pr
 		Username:     []byte(loginReq.Username),
 		Password:     []byte(loginReq.Password),
 		DatabaseName: loginReq.Database,
+		SessionID:    loginReq.SessionID,
 	})
 	if err != nil {
 		return nil, err
@@ -46,12 +48,18 @@ func (s *authenticationServiceImp) OpenSession(ctx context.Context, loginReq *pr
 	inactivityTimestamp := int32(0)
 	now := time.Now()
 
+	// the maximum age of a resumed session is counted from its creation
+	createdAt := now
+	if sess, err := s.server.SessManager.GetSession(session.SessionID); err == nil {
+		createdAt = sess.GetCreationTime()
+	}
+
 	if s.server.Options.SessionsOptions.MaxSessionInactivityTime > 0 {
 		inactivityTimestamp = int32(now.Add(s.server.Options.SessionsOptions.MaxSessionInactivityTime).Unix())
 	}
 
 	if s.server.Options.SessionsOptions.MaxSessionAgeTime > 0 && s.server.Options.SessionsOptions.MaxSessionAgeTime != infinity {
-		expirationTimestamp = int32(now.Add(s.server.Options.SessionsOptions.MaxSessionAgeTime).Unix())
+		expirationTimestamp = int32(createdAt.Add(s.server.Options.SessionsOptions.MaxSessionAgeTime).Unix())
 	}
 
 	return &protomodel.OpenSessionResponse{
@@ -63,12 +71,33 @@ func (s *authenticationServiceImp) OpenSession(ctx context.Context, loginReq *pr
 }
 
 func (s *authenticationServiceImp) KeepAlive(ctx context.Context, _ *protomodel.KeepAliveRequest) (*protomodel.KeepAliveResponse, error) {
-	_, err := s.server.KeepAlive(ctx, &emptypb.Empty{})
+	sessionID, err := sessions.GetSessionIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &protomodel.KeepAliveResponse{}, nil
+	heartbeat, err := s.server.SessManager.Heartbeat(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &protomodel.KeepAliveResponse{
+		Status: protomodel.SessionStatus_ACTIVE,
+	}
+
+	if heartbeat.Status == sessions.SessionDraining {
+		res.Status = protomodel.SessionStatus_DRAINING
+	}
+
+	if !heartbeat.Deadline.IsZero() {
+		res.DeadlineTimestamp = int32(heartbeat.Deadline.Unix())
+	}
+
+	if !heartbeat.InactiveAt.IsZero() {
+		res.InactivityTimestamp = int32(heartbeat.InactiveAt.Unix())
+	}
+
+	return res, nil
 }
 
 func (s *authenticationServiceImp) CloseSession(ctx context.Context, _ *protomodel.CloseSessionRequest) (*protomodel.CloseSessionResponse, error) {
@@ -260,6 +260,11 @@ func (d *closedDB) GetCollection(ctx context.Context, req *protomodel.GetCollect
 	return nil, store.ErrAlreadyClosed
 }
 
+// GetCollectionStats returns the document count and index statistics of a collection
+func (d *closedDB) GetCollectionStats(ctx context.Context, req *protomodel.GetCollectionStatsRequest) (*protomodel.GetCollectionStatsResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (d *closedDB) GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -300,7 +305,23 @@ func (d *closedDB) AuditDocument(ctx context.Context, req *protomodel.AuditDocum
 	return nil, store.ErrAlreadyClosed
 }
 
-func (d *closedDB) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
+func (d *closedDB) DocumentsSnapshotTx(ctx context.Context, atTx uint64) (uint64, error) {
+	return 0, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) SearchDocuments(ctx context.Context, query *protomodel.Query, atTx uint64, offset int64) (document.DocumentReader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) SearchDocumentsAfter(ctx context.Context, query *protomodel.Query, atTx uint64, cursor *document.Cursor) (document.DocumentReader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) ExplainDocuments(ctx context.Context, query *protomodel.Query, atTx uint64) (*protomodel.QueryPlan, bool, error) {
+	return nil, false, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) NewDocumentCursor(query *protomodel.Query, rev *protomodel.DocumentAtRevision) (*document.Cursor, error) {
 	return nil, store.ErrAlreadyClosed
 }
 
@@ -312,6 +333,10 @@ func (d *closedDB) ProofDocument(ctx context.Context, req *protomodel.ProofDocum
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) DeleteExpiredDocuments(ctx context.Context) error {
+	return store.ErrAlreadyClosed
+}
+
 func (d *closedDB) DeleteDocuments(ctx context.Context, username string, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
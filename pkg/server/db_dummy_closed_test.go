@@ -210,7 +210,10 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.AuditDocument(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
-	_, err = cdb.SearchDocuments(context.Background(), nil, 0)
+	_, err = cdb.DocumentsSnapshotTx(context.Background(), 0)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.SearchDocuments(context.Background(), nil, 0, 0)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
 	_, err = cdb.CountDocuments(context.Background(), nil)
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+// startDocumentExpiration periodically deletes the expired documents of every
+// loaded database. Databases which are not currently loaded are skipped.
+func (s *ImmuServer) startDocumentExpiration() {
+	if s.Options.DocumentExpirationInterval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	s.documentExpirationStop = stop
+	s.documentExpirationDone = done
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.Options.DocumentExpirationInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				s.deleteExpiredDocuments(context.Background())
+			}
+		}
+	}()
+
+	s.Logger.Infof("document expiration started")
+}
+
+func (s *ImmuServer) stopDocumentExpiration() {
+	if s.documentExpirationStop == nil {
+		return
+	}
+
+	close(s.documentExpirationStop)
+	<-s.documentExpirationDone
+
+	s.documentExpirationStop = nil
+	s.documentExpirationDone = nil
+}
+
+func (s *ImmuServer) deleteExpiredDocuments(ctx context.Context) {
+	s.dbListMutex.Lock()
+	n := s.dbList.Length()
+	s.dbListMutex.Unlock()
+
+	for i := 0; i < n; i++ {
+		db, err := s.dbList.GetByIndex(i)
+		if err != nil {
+			continue
+		}
+
+		err = db.DeleteExpiredDocuments(ctx)
+		if errors.Is(err, database.ErrIsReplica) || errors.Is(err, store.ErrAlreadyClosed) {
+			continue
+		}
+		if err != nil {
+			s.Logger.Warningf("error deleting expired documents in database '%s'. Reason: %v", db.GetName(), err)
+		}
+	}
+}
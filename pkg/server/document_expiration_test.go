@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDeleteExpiredDocuments(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithDocumentExpirationInterval(0)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+	require.NoError(t, s.Initialize())
+
+	authenticationServiceImp := &authenticationServiceImp{s}
+
+	logged, err := authenticationServiceImp.OpenSession(context.Background(), &protomodel.OpenSessionRequest{
+		Username: "immudb",
+		Password: "immudb",
+		Database: "defaultdb",
+	})
+	require.NoError(t, err)
+
+	md := metadata.Pairs("sessionid", logged.SessionID)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	collectionName := "mycollection"
+
+	_, err = s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+		Name: collectionName,
+		Fields: []*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+		},
+		Ttl: &protomodel.DocumentTTL{DefaultSeconds: 3600},
+	})
+	require.NoError(t, err)
+
+	res, err := s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+		CollectionName: collectionName,
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{
+				"country":    structpb.NewStringValue("wonderland"),
+				"_expiresAt": structpb.NewNumberValue(float64(time.Now().Unix() - 1)),
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.DocumentIds, 1)
+
+	s.deleteExpiredDocuments(context.Background())
+
+	// the deletion is visible in the history of the document once indexed
+	require.Eventually(t, func() bool {
+		auditRes, err := s.AuditDocument(ctx, &protomodel.AuditDocumentRequest{
+			CollectionName: collectionName,
+			DocumentId:     res.DocumentIds[0],
+			Page:           1,
+			PageSize:       10,
+		})
+		require.NoError(t, err)
+
+		return len(auditRes.Revisions) == 2 && auditRes.Revisions[1].Metadata.GetDeleted()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("start and stop", func(t *testing.T) {
+		s.Options.WithDocumentExpirationInterval(10 * time.Millisecond)
+
+		s.startDocumentExpiration()
+		require.NotNil(t, s.documentExpirationStop)
+
+		time.Sleep(50 * time.Millisecond)
+
+		s.stopDocumentExpiration()
+		require.Nil(t, s.documentExpirationStop)
+
+		// stopping twice is a no-op
+		s.stopDocumentExpiration()
+	})
+}
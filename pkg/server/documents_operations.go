@@ -24,17 +24,42 @@ import (
 	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/rs/xid"
 )
 
+// checkCollectionPermission verifies the logged in user can access the collection with the given permission.
+// It's done before reaching the database, so denied operations never touch the store.
+func (s *ImmuServer) checkCollectionPermission(ctx context.Context, db database.DB, collectionName string, permission uint32) error {
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		if !s.Options.auth {
+			// there are no users to check when authentication is disabled
+			return nil
+		}
+		return err
+	}
+
+	if !user.HasCollectionPermission(db.GetName(), collectionName, permission) {
+		return ErrCollectionPermissionDenied
+	}
+
+	return nil
+}
+
 func (s *ImmuServer) CreateCollection(ctx context.Context, req *protomodel.CreateCollectionRequest) (*protomodel.CreateCollectionResponse, error) {
 	db, err := s.getDBFromCtx(ctx, "CreateCollection")
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -49,6 +74,11 @@ func (s *ImmuServer) UpdateCollection(ctx context.Context, req *protomodel.Updat
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -63,16 +93,57 @@ func (s *ImmuServer) GetCollection(ctx context.Context, req *protomodel.GetColle
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetName(), auth.PermissionR)
+	if err != nil {
+		return nil, err
+	}
+
 	return db.GetCollection(ctx, req)
 }
 
+func (s *ImmuServer) GetCollectionStats(ctx context.Context, req *protomodel.GetCollectionStatsRequest) (*protomodel.GetCollectionStatsResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "GetCollectionStats")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkCollectionPermission(ctx, db, req.GetName(), auth.PermissionR)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.GetCollectionStats(ctx, req)
+}
+
 func (s *ImmuServer) GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
 	db, err := s.getDBFromCtx(ctx, "GetCollections")
 	if err != nil {
 		return nil, err
 	}
 
-	return db.GetCollections(ctx, req)
+	res, err := db.GetCollections(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// collections the user can not read are not disclosed
+	collections := res.Collections[:0]
+
+	for _, collection := range res.Collections {
+		err := s.checkCollectionPermission(ctx, db, collection.Name, auth.PermissionR)
+		if errors.Is(err, ErrCollectionPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		collections = append(collections, collection)
+	}
+
+	res.Collections = collections
+
+	return res, nil
 }
 
 func (s *ImmuServer) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollectionRequest) (*protomodel.DeleteCollectionResponse, error) {
@@ -81,6 +152,11 @@ func (s *ImmuServer) DeleteCollection(ctx context.Context, req *protomodel.Delet
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -95,6 +171,11 @@ func (s *ImmuServer) AddField(ctx context.Context, req *protomodel.AddFieldReque
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -109,6 +190,11 @@ func (s *ImmuServer) RemoveField(ctx context.Context, req *protomodel.RemoveFiel
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -123,6 +209,11 @@ func (s *ImmuServer) CreateIndex(ctx context.Context, req *protomodel.CreateInde
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -137,6 +228,11 @@ func (s *ImmuServer) DeleteIndex(ctx context.Context, req *protomodel.DeleteInde
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -151,6 +247,11 @@ func (s *ImmuServer) InsertDocuments(ctx context.Context, req *protomodel.Insert
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -165,6 +266,11 @@ func (s *ImmuServer) ReplaceDocuments(ctx context.Context, req *protomodel.Repla
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetQuery().GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -179,6 +285,11 @@ func (s *ImmuServer) AuditDocument(ctx context.Context, req *protomodel.AuditDoc
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionR)
+	if err != nil {
+		return nil, err
+	}
+
 	return db.AuditDocument(ctx, req)
 }
 
@@ -192,6 +303,21 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		return nil, ErrIllegalArguments
 	}
 
+	if req.Query != nil {
+		err = s.checkCollectionPermission(ctx, db, req.Query.CollectionName, auth.PermissionR)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Explain {
+		return s.explainSearchDocuments(ctx, db, req)
+	}
+
+	if req.CursorPagination {
+		return s.searchDocumentsWithCursor(ctx, db, req)
+	}
+
 	if req.SearchId != "" && req.Query != nil {
 		return nil, fmt.Errorf("%w: query or searchId must be specified, not both", ErrIllegalArguments)
 	}
@@ -200,9 +326,9 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		return nil, fmt.Errorf("%w: invalid page or page size", ErrIllegalArguments)
 	}
 
-	if int(req.PageSize) > db.MaxResultSize() {
-		return nil, fmt.Errorf("%w: the specified page size (%d) is larger than the maximum allowed one (%d)",
-			database.ErrResultSizeLimitExceeded, req.PageSize, db.MaxResultSize())
+	pageSize, pageSizeClamped, err := s.documentsPageSize(db, req.PageSize)
+	if err != nil {
+		return nil, err
 	}
 
 	// get the session from the context
@@ -218,6 +344,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 
 	searchID := req.SearchId
 	query := req.Query
+	atTx := req.AtTx
 
 	var pgreader *sessions.PaginatedDocumentReader
 
@@ -231,9 +358,19 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 			return nil, err
 		}
 
+		// the collection is only known from the stored query when resuming a search
+		err = s.checkCollectionPermission(ctx, db, pgreader.Query.GetCollectionName(), auth.PermissionR)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.AtTx != 0 && req.AtTx != pgreader.AtTx {
+			return nil, fmt.Errorf("%w: the search is being read at transaction %d", ErrIllegalArguments, pgreader.AtTx)
+		}
+
 		// paginated reader already exists, resume reading from the correct offset based
 		// on pagination parameters, do validation on the pagination parameters
-		if req.Page != pgreader.LastPageNumber+1 || req.PageSize != pgreader.LastPageSize {
+		if req.Page != pgreader.LastPageNumber+1 || pageSize != pgreader.LastPageSize {
 			if pgreader.Reader != nil {
 				err := pgreader.Reader.Close()
 				if err != nil {
@@ -242,15 +379,21 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 			}
 
 			query = pgreader.Query
+			atTx = pgreader.AtTx
 			pgreader = nil
 		}
 	}
 
 	if pgreader == nil {
 		// create a new reader and add it to the session
-		offset := int64((req.Page - 1) * req.PageSize)
+		offset := int64((req.Page - 1) * pageSize)
+
+		snapshotTx, err := db.DocumentsSnapshotTx(ctx, atTx)
+		if err != nil {
+			return nil, err
+		}
 
-		docReader, err := db.SearchDocuments(ctx, query, offset)
+		docReader, err := db.SearchDocuments(ctx, query, snapshotTx, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -260,14 +403,15 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 			Reader:         docReader,
 			Query:          query,
 			LastPageNumber: req.Page,
-			LastPageSize:   req.PageSize,
+			LastPageSize:   pageSize,
+			AtTx:           snapshotTx,
 		}
 
 		sess.SetPaginatedDocumentReader(searchID, pgreader)
 	}
 
 	// read the next page of data from the paginated reader
-	docs, err := pgreader.Reader.ReadN(ctx, int(req.PageSize))
+	docs, err := pgreader.Reader.ReadN(ctx, int(pageSize))
 	if err != nil && !errors.Is(err, document.ErrNoMoreDocuments) {
 		return nil, err
 	}
@@ -280,16 +424,150 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		}
 
 		return &protomodel.SearchDocumentsResponse{
-			Revisions: docs,
+			Revisions:       docs,
+			FullScan:        pgreader.Reader.FullScan(),
+			AtTx:            pgreader.AtTx,
+			PageSize:        pageSize,
+			PageSizeClamped: pageSizeClamped,
 		}, nil
 	}
 
 	// update the pagination parameters for this query in the session
-	sess.UpdatePaginatedDocumentReader(searchID, req.Page, req.PageSize)
+	sess.UpdatePaginatedDocumentReader(searchID, req.Page, pageSize)
+
+	return &protomodel.SearchDocumentsResponse{
+		SearchId:        searchID,
+		Revisions:       docs,
+		FullScan:        pgreader.Reader.FullScan(),
+		AtTx:            pgreader.AtTx,
+		PageSize:        pageSize,
+		PageSizeClamped: pageSizeClamped,
+	}, nil
+}
+
+// searchDocumentsWithCursor reads the page of documents following the cursor of the request,
+// no state is kept in the session between pages
+func (s *ImmuServer) searchDocumentsWithCursor(ctx context.Context, db database.DB, req *protomodel.SearchDocumentsRequest) (*protomodel.SearchDocumentsResponse, error) {
+	if req.SearchId != "" {
+		return nil, fmt.Errorf("%w: searchId can not be used with cursor pagination", ErrIllegalArguments)
+	}
+
+	if req.Query == nil {
+		return nil, fmt.Errorf("%w: query must be specified", ErrIllegalArguments)
+	}
+
+	if req.Query.Limit > 0 {
+		return nil, fmt.Errorf("%w: query limit can not be used with cursor pagination", ErrIllegalArguments)
+	}
+
+	if req.PageSize < 1 {
+		return nil, fmt.Errorf("%w: invalid page size", ErrIllegalArguments)
+	}
+
+	pageSize, pageSizeClamped, err := s.documentsPageSize(db, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor *document.Cursor
+
+	if req.Cursor != "" {
+		var err error
+
+		cursor, err = document.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	atTx, err := db.DocumentsSnapshotTx(ctx, req.AtTx)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := db.SearchDocumentsAfter(ctx, req.Query, atTx, cursor)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	docs, err := reader.ReadN(ctx, int(pageSize))
+	if errors.Is(err, document.ErrNoMoreDocuments) {
+		return &protomodel.SearchDocumentsResponse{
+			Revisions:       docs,
+			FullScan:        reader.FullScan(),
+			AtTx:            atTx,
+			PageSize:        pageSize,
+			PageSizeClamped: pageSizeClamped,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor, err := db.NewDocumentCursor(req.Query, docs[len(docs)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	encodedCursor, err := nextCursor.Encode()
+	if err != nil {
+		return nil, err
+	}
 
 	return &protomodel.SearchDocumentsResponse{
-		SearchId:  searchID,
-		Revisions: docs,
+		Revisions:       docs,
+		FullScan:        reader.FullScan(),
+		NextCursor:      encodedCursor,
+		AtTx:            atTx,
+		PageSize:        pageSize,
+		PageSizeClamped: pageSizeClamped,
+	}, nil
+}
+
+// documentsPageSize returns the page size used to serve a search, page sizes above the maximum
+// allowed one are either clamped or rejected depending on the server options
+func (s *ImmuServer) documentsPageSize(db database.DB, pageSize uint32) (uint32, bool, error) {
+	maxPageSize := db.MaxResultSize()
+	if s.Options.MaxDocumentsPageSize > 0 && s.Options.MaxDocumentsPageSize < maxPageSize {
+		maxPageSize = s.Options.MaxDocumentsPageSize
+	}
+
+	if int(pageSize) <= maxPageSize {
+		return pageSize, false, nil
+	}
+
+	if s.Options.ClampDocumentsPageSize {
+		return uint32(maxPageSize), true, nil
+	}
+
+	return 0, false, fmt.Errorf("%w: the specified page size (%d) is larger than the maximum allowed one (%d)",
+		database.ErrResultSizeLimitExceeded, pageSize, maxPageSize)
+}
+
+func (s *ImmuServer) explainSearchDocuments(ctx context.Context, db database.DB, req *protomodel.SearchDocumentsRequest) (*protomodel.SearchDocumentsResponse, error) {
+	if req.SearchId != "" {
+		return nil, fmt.Errorf("%w: searchId can not be used when explaining a query", ErrIllegalArguments)
+	}
+
+	if req.Query == nil {
+		return nil, fmt.Errorf("%w: query must be specified", ErrIllegalArguments)
+	}
+
+	atTx, err := db.DocumentsSnapshotTx(ctx, req.AtTx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, fullScan, err := db.ExplainDocuments(ctx, req.Query, atTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protomodel.SearchDocumentsResponse{
+		FullScan: fullScan,
+		AtTx:     atTx,
+		Plan:     plan,
 	}, nil
 }
 
@@ -299,6 +577,11 @@ func (s *ImmuServer) CountDocuments(ctx context.Context, req *protomodel.CountDo
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetQuery().GetCollectionName(), auth.PermissionR)
+	if err != nil {
+		return nil, err
+	}
+
 	return db.CountDocuments(ctx, req)
 }
 
@@ -308,6 +591,11 @@ func (s *ImmuServer) DeleteDocuments(ctx context.Context, req *protomodel.Delete
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetQuery().GetCollectionName(), auth.PermissionRW)
+	if err != nil {
+		return nil, err
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get loggedin user data")
@@ -322,6 +610,11 @@ func (s *ImmuServer) ProofDocument(ctx context.Context, req *protomodel.ProofDoc
 		return nil, err
 	}
 
+	err = s.checkCollectionPermission(ctx, db, req.GetCollectionName(), auth.PermissionR)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := db.ProofDocument(ctx, req)
 	if err != nil {
 		return nil, err
@@ -22,11 +22,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/document"
+	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/server/sessions"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/codenotary/immudb/pkg/verification"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -54,6 +63,9 @@ func TestV2Authentication(t *testing.T) {
 	_, err = s.GetCollection(ctx, &protomodel.GetCollectionRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
+	_, err = s.GetCollectionStats(ctx, &protomodel.GetCollectionStatsRequest{})
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+
 	_, err = s.GetCollections(ctx, &protomodel.GetCollectionsRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
@@ -122,8 +134,11 @@ func TestV2Authentication(t *testing.T) {
 	md := metadata.Pairs("sessionid", logged.SessionID)
 	ctx = metadata.NewIncomingContext(context.Background(), md)
 
-	_, err = authServiceImp.KeepAlive(ctx, &protomodel.KeepAliveRequest{})
+	keepAliveRes, err := authServiceImp.KeepAlive(ctx, &protomodel.KeepAliveRequest{})
 	require.NoError(t, err)
+	require.Equal(t, protomodel.SessionStatus_ACTIVE, keepAliveRes.Status)
+	require.Greater(t, keepAliveRes.DeadlineTimestamp, int32(0))
+	require.GreaterOrEqual(t, keepAliveRes.InactivityTimestamp, logged.InactivityTimestamp)
 
 	_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{})
 	require.NotErrorIs(t, err, ErrNotLoggedIn)
@@ -297,6 +312,109 @@ func TestPaginationOnReader(t *testing.T) {
 		})
 	})
 
+	t.Run("test explain", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_GE,
+							Value:    structpb.NewNumberValue(16),
+						},
+					},
+				},
+			},
+			OrderBy: []*protomodel.OrderByClause{{Field: "pincode"}},
+		}
+
+		_, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			SearchId: "foobar",
+			Query:    query,
+			Explain:  true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Explain: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:   query,
+			Explain: true,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Revisions)
+		require.NotZero(t, resp.AtTx)
+
+		require.Equal(t, []string{"pincode"}, resp.Plan.Index.Fields)
+		require.Len(t, resp.Plan.Ranges, 1)
+		require.Equal(t, 16.0, resp.Plan.Ranges[0].LowerBound.GetNumberValue())
+		require.True(t, resp.Plan.Ranges[0].LowerInclusive)
+		require.Nil(t, resp.Plan.Ranges[0].UpperBound)
+		require.Equal(t, uint64(20), resp.Plan.EstimatedDocuments)
+		require.Equal(t, uint64(5), resp.Plan.ScannedDocuments)
+		require.Equal(t, uint64(5), resp.Plan.MatchedDocuments)
+	})
+
+	t.Run("test cursor pagination", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "idx", Desc: true}},
+		}
+
+		_, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			SearchId:         "foobar",
+			Query:            query,
+			PageSize:         5,
+			CursorPagination: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:            query,
+			CursorPagination: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:            query,
+			PageSize:         5,
+			CursorPagination: true,
+			Cursor:           "invalid cursor",
+		})
+		require.ErrorIs(t, err, document.ErrInvalidCursor)
+
+		var results []*protomodel.DocumentAtRevision
+		var cursor string
+
+		for {
+			resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+				Query:            query,
+				PageSize:         6,
+				CursorPagination: true,
+				Cursor:           cursor,
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.SearchId)
+
+			results = append(results, resp.Revisions...)
+
+			if resp.NextCursor == "" {
+				break
+			}
+			cursor = resp.NextCursor
+		}
+
+		require.Len(t, results, 20)
+
+		for i, rev := range results {
+			require.Equal(t, float64(20-i), rev.Document.Fields["idx"].GetNumberValue())
+		}
+	})
+
 	t.Run("test reader should throw error on reading backwards", func(t *testing.T) {
 		var searchID string
 
@@ -337,6 +455,84 @@ func TestPaginationOnReader(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("test full scan is reported for text matching queries", func(t *testing.T) {
+		search := func(op protomodel.ComparisonOperator, caseInsensitive bool) *protomodel.SearchDocumentsResponse {
+			resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+				Query: &protomodel.Query{
+					CollectionName: collectionName,
+					Expressions: []*protomodel.QueryExpression{
+						{
+							FieldComparisons: []*protomodel.FieldComparison{
+								{
+									Field:           "country",
+									Operator:        op,
+									Value:           structpb.NewStringValue("country-1"),
+									CaseInsensitive: caseInsensitive,
+								},
+							},
+						},
+					},
+				},
+				Page:     1,
+				PageSize: 20,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		resp := search(protomodel.ComparisonOperator_PREFIX, false)
+		require.Len(t, resp.Revisions, 11)
+		require.False(t, resp.FullScan)
+
+		resp = search(protomodel.ComparisonOperator_PREFIX, true)
+		require.Len(t, resp.Revisions, 11)
+		require.True(t, resp.FullScan)
+
+		resp = search(protomodel.ComparisonOperator_CONTAINS, false)
+		require.Len(t, resp.Revisions, 11)
+		require.True(t, resp.FullScan)
+	})
+
+	t.Run("test full scan is reported for prefix queries on fields not covered by an index", func(t *testing.T) {
+		_, err := s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+			Name: "unindexed",
+			Fields: []*protomodel.Field{
+				{Name: "country", Type: protomodel.FieldType_STRING},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "unindexed",
+			Documents: []*structpb.Struct{
+				{Fields: map[string]*structpb.Value{"country": structpb.NewStringValue("country-1")}},
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: "unindexed",
+				Expressions: []*protomodel.QueryExpression{
+					{
+						FieldComparisons: []*protomodel.FieldComparison{
+							{
+								Field:    "country",
+								Operator: protomodel.ComparisonOperator_PREFIX,
+								Value:    structpb.NewStringValue("country-1"),
+							},
+						},
+					},
+				},
+			},
+			Page:     1,
+			PageSize: 20,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 1)
+		require.True(t, resp.FullScan)
+	})
+
 	// close session and ensure that all paginated readers are closed
 	_, err = authenticationServiceImp.CloseSession(ctx, &protomodel.CloseSessionRequest{})
 	require.NoError(t, err)
@@ -447,6 +643,96 @@ func TestPaginationWithoutSearchID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSearchDocumentsMaxPageSize(t *testing.T) {
+	serverOptions := DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithMaxDocumentsPageSize(5)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+	require.NoError(t, s.Initialize())
+
+	authServiceImp := &authenticationServiceImp{server: s}
+
+	logged, err := authServiceImp.OpenSession(context.Background(), &protomodel.OpenSessionRequest{
+		Username: "immudb",
+		Password: "immudb",
+		Database: "defaultdb",
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", logged.SessionID))
+	defer authServiceImp.CloseSession(ctx, &protomodel.CloseSessionRequest{})
+
+	collectionName := "mycollection"
+
+	_, err = s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+		Name:    collectionName,
+		Fields:  []*protomodel.Field{{Name: "idx", Type: protomodel.FieldType_INTEGER}},
+		Indexes: []*protomodel.Index{{Fields: []string{"idx"}}},
+	})
+	require.NoError(t, err)
+
+	for i := 1.0; i <= 12; i++ {
+		_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+			CollectionName: collectionName,
+			Documents: []*structpb.Struct{
+				{Fields: map[string]*structpb.Value{"idx": structpb.NewNumberValue(i)}},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		OrderBy:        []*protomodel.OrderByClause{{Field: "idx"}},
+	}
+
+	t.Run("page sizes within the maximum are used as requested", func(t *testing.T) {
+		res, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{Query: query, Page: 1, PageSize: 3})
+		require.NoError(t, err)
+		require.Len(t, res.Revisions, 3)
+		require.Equal(t, uint32(3), res.PageSize)
+		require.False(t, res.PageSizeClamped)
+	})
+
+	t.Run("page sizes above the maximum are rejected by default", func(t *testing.T) {
+		_, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{Query: query, Page: 1, PageSize: 10})
+		require.ErrorIs(t, err, database.ErrResultSizeLimitExceeded)
+
+		_, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{Query: query, PageSize: 10, CursorPagination: true})
+		require.ErrorIs(t, err, database.ErrResultSizeLimitExceeded)
+	})
+
+	s.Options.WithClampDocumentsPageSize(true)
+
+	t.Run("page sizes above the maximum are clamped", func(t *testing.T) {
+		res, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{Query: query, Page: 1, PageSize: 10, KeepOpen: true})
+		require.NoError(t, err)
+		require.Len(t, res.Revisions, 5)
+		require.Equal(t, uint32(5), res.PageSize)
+		require.True(t, res.PageSizeClamped)
+
+		// the search is resumed using the clamped page size
+		res, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{SearchId: res.SearchId, Page: 2, PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, res.Revisions, 5)
+		require.Equal(t, 6.0, res.Revisions[0].Document.Fields["idx"].GetNumberValue())
+		require.True(t, res.PageSizeClamped)
+	})
+
+	t.Run("page sizes above the maximum are clamped with cursor pagination", func(t *testing.T) {
+		res, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{Query: query, PageSize: 10, CursorPagination: true})
+		require.NoError(t, err)
+		require.Len(t, res.Revisions, 5)
+		require.Equal(t, uint32(5), res.PageSize)
+		require.True(t, res.PageSizeClamped)
+		require.NotEmpty(t, res.NextCursor)
+	})
+}
+
 func TestPaginatedReader_NoMoreDocsFound(t *testing.T) {
 	dir := t.TempDir()
 
@@ -622,6 +908,92 @@ func TestPaginatedReader_NoMoreDocsFound(t *testing.T) {
 
 	})
 
+	t.Run("pages read at the same transaction should not be affected by later changes", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "idx"}},
+		}
+
+		resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:            query,
+			PageSize:         5,
+			CursorPagination: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 5)
+		require.NotZero(t, resp.AtTx)
+
+		atTx := resp.AtTx
+		nextCursor := resp.NextCursor
+
+		_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+			CollectionName: collectionName,
+			Documents: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"pincode": structpb.NewNumberValue(0),
+						"country": structpb.NewStringValue("country-0"),
+						"idx":     structpb.NewNumberValue(0),
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:            query,
+			PageSize:         5,
+			CursorPagination: true,
+			Cursor:           nextCursor,
+			AtTx:             atTx,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 5)
+		require.Equal(t, atTx, resp.AtTx)
+		require.EqualValues(t, 6, resp.Revisions[0].Document.Fields["idx"].GetNumberValue())
+
+		resp, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:    query,
+			Page:     1,
+			PageSize: 5,
+			AtTx:     atTx,
+			KeepOpen: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, atTx, resp.AtTx)
+		require.EqualValues(t, 1, resp.Revisions[0].Document.Fields["idx"].GetNumberValue())
+
+		_, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			SearchId: resp.SearchId,
+			Page:     2,
+			PageSize: 5,
+			AtTx:     atTx + 1,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		resp, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query:    query,
+			Page:     1,
+			PageSize: 5,
+		})
+		require.NoError(t, err)
+		require.Greater(t, resp.AtTx, atTx)
+		require.EqualValues(t, 0, resp.Revisions[0].Document.Fields["idx"].GetNumberValue())
+
+		t.Run("reading at a transaction not yet committed should wait for it", func(t *testing.T) {
+			waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			_, err := s.SearchDocuments(waitCtx, &protomodel.SearchDocumentsRequest{
+				Query:    query,
+				Page:     1,
+				PageSize: 5,
+				AtTx:     resp.AtTx + 1,
+			})
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+		})
+	})
+
 	t.Run("document deletion should succeed", func(t *testing.T) {
 		_, err = s.DeleteDocuments(ctx, &protomodel.DeleteDocumentsRequest{
 			Query: &protomodel.Query{
@@ -804,6 +1176,25 @@ func TestCollections(t *testing.T) {
 		}
 	})
 
+	t.Run("should pass when getting collection stats", func(t *testing.T) {
+		res, err := s.GetCollectionStats(ctx, &protomodel.GetCollectionStatsRequest{
+			Name: defaultCollectionName,
+		})
+		require.NoError(t, err)
+
+		stats := res.Stats
+		require.Equal(t, defaultCollectionName, stats.Name)
+		require.Zero(t, stats.DocumentCount)
+		require.Len(t, stats.Indexes, 2)
+		require.Equal(t, []string{"_id"}, stats.Indexes[0].Fields)
+		require.Equal(t, []string{"number"}, stats.Indexes[1].Fields)
+
+		_, err = s.GetCollectionStats(ctx, &protomodel.GetCollectionStatsRequest{
+			Name: "unknown",
+		})
+		require.ErrorIs(t, err, document.ErrCollectionDoesNotExist)
+	})
+
 	t.Run("should pass when deleting an index to the collection", func(t *testing.T) {
 		_, err := s.DeleteIndex(ctx, &protomodel.DeleteIndexRequest{
 			CollectionName: defaultCollectionName,
@@ -948,6 +1339,70 @@ func TestDocuments(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("should fail with AlreadyExists when inserting a duplicated document", func(t *testing.T) {
+		_, err := s.CreateCollection(ctx, &protomodel.CreateCollectionRequest{
+			Name: "customers",
+			Fields: []*protomodel.Field{
+				{Name: "email", Type: protomodel.FieldType_STRING},
+			},
+			Indexes: []*protomodel.Index{
+				{Fields: []string{"email"}, IsUnique: true},
+			},
+		})
+		require.NoError(t, err)
+
+		doc := &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"email": structpb.NewStringValue("customer1@example.com"),
+			},
+		}
+
+		_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "customers",
+			Documents:      []*structpb.Struct{doc},
+		})
+		require.NoError(t, err)
+
+		// insertions don't wait for a snapshot of the index including the latest documents,
+		// the stored document is found once such a snapshot was taken by a query using the index
+		countRes, err := s.CountDocuments(ctx, &protomodel.CountDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: "customers",
+				Expressions: []*protomodel.QueryExpression{{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{Field: "email", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("customer1@example.com")},
+					},
+				}},
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, countRes.Count)
+
+		_, err = s.InsertDocuments(ctx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "customers",
+			Documents: []*structpb.Struct{{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("customer1@example.com"),
+				},
+			}},
+		})
+		require.ErrorIs(t, err, document.ErrDocumentAlreadyExists)
+
+		// as reported to clients by the error mapper interceptor
+		st, ok := status.FromError(mapServerError(err))
+		require.True(t, ok)
+		require.Equal(t, codes.AlreadyExists, st.Code())
+
+		var errorInfo *schema.ErrorInfo
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*schema.ErrorInfo); ok {
+				errorInfo = info
+			}
+		}
+		require.NotNil(t, errorInfo)
+		require.Equal(t, "23505", errorInfo.Code)
+	})
+
 	var res *protomodel.InsertDocumentsResponse
 	var docID string
 	t.Run("should pass when adding documents", func(t *testing.T) {
@@ -1008,4 +1463,264 @@ func TestDocuments(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("should verify document proof against the signing key", func(t *testing.T) {
+		searchRes, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+			},
+			Page:     1,
+			PageSize: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, searchRes.Revisions, 1)
+
+		doc := searchRes.Revisions[0].Document
+
+		proofRes, err := s.ProofDocument(ctx, &protomodel.ProofDocumentRequest{
+			CollectionName: collectionName,
+			DocumentId:     docID,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, proofRes.VerifiableTx.Signature)
+
+		pubKey, err := signer.ParsePublicKeyFile("./../../test/signer/ec1.pub")
+		require.NoError(t, err)
+
+		state, err := verification.VerifyDocument(ctx, proofRes, doc, nil, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, proofRes.VerifiableTx.DualProof.TargetTxHeader.Id, state.TxId)
+
+		otherPubKey, err := signer.ParsePublicKeyFile("./../../test/signer/ec3.pub")
+		require.NoError(t, err)
+
+		_, err = verification.VerifyDocument(ctx, proofRes, doc, nil, otherPubKey)
+		require.ErrorIs(t, err, store.ErrInvalidProof)
+
+		proofRes.VerifiableTx.Signature = nil
+
+		_, err = verification.VerifyDocument(ctx, proofRes, doc, nil, pubKey)
+		require.ErrorIs(t, err, store.ErrInvalidProof)
+
+		_, err = verification.VerifyDocument(ctx, proofRes, doc, nil, nil)
+		require.NoError(t, err)
+	})
+
+}
+
+func TestCollectionPermissions(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+	require.NoError(t, s.Initialize())
+
+	authenticationServiceImp := &authenticationServiceImp{s}
+
+	openSession := func(username, password string) context.Context {
+		logged, err := authenticationServiceImp.OpenSession(context.Background(), &protomodel.OpenSessionRequest{
+			Username: username,
+			Password: password,
+			Database: "defaultdb",
+		})
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", logged.SessionID))
+	}
+
+	adminCtx := openSession(auth.SysAdminUsername, auth.SysAdminPassword)
+
+	for _, collection := range []string{"tenant1", "tenant2"} {
+		_, err := s.CreateCollection(adminCtx, &protomodel.CreateCollectionRequest{
+			Name:   collection,
+			Fields: []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := s.CreateUser(adminCtx, &schema.CreateUserRequest{
+		User:       []byte("user1"),
+		Password:   []byte("Pa$$w0rd1"),
+		Database:   DefaultDBName,
+		Permission: auth.PermissionRW,
+	})
+	require.NoError(t, err)
+
+	t.Run("invalid requests should fail", func(t *testing.T) {
+		_, err := s.ChangeCollectionPermission(adminCtx, &schema.ChangeCollectionPermissionRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   "user1",
+			Database:   DefaultDBName,
+			Permission: auth.PermissionRW,
+		})
+		require.ErrorContains(t, err, "collection can not be empty")
+
+		_, err = s.ChangeCollectionPermission(adminCtx, &schema.ChangeCollectionPermissionRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   "user1",
+			Database:   DefaultDBName,
+			Collection: "tenant1",
+			Permission: auth.PermissionAdmin,
+		})
+		require.ErrorContains(t, err, "unrecognized permission")
+
+		_, err = s.ChangeCollectionPermission(adminCtx, &schema.ChangeCollectionPermissionRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   "user2",
+			Database:   DefaultDBName,
+			Collection: "tenant1",
+			Permission: auth.PermissionRW,
+		})
+		require.ErrorContains(t, err, "user user2 not found")
+	})
+
+	_, err = s.ChangeCollectionPermission(adminCtx, &schema.ChangeCollectionPermissionRequest{
+		Action:     schema.PermissionAction_GRANT,
+		Username:   "user1",
+		Database:   DefaultDBName,
+		Collection: "tenant1",
+		Permission: auth.PermissionRW,
+	})
+	require.NoError(t, err)
+
+	users, err := s.ListUsers(adminCtx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	for _, u := range users.Users {
+		if string(u.User) == "user1" {
+			require.Equal(t, []*schema.CollectionPermission{{Database: DefaultDBName, Collection: "tenant1", Permission: auth.PermissionRW}}, u.CollectionPermissions)
+		}
+	}
+
+	userCtx := openSession("user1", "Pa$$w0rd1")
+
+	newDoc := func() *structpb.Struct {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("doc1")}}
+	}
+
+	t.Run("granted collection should be accessible", func(t *testing.T) {
+		_, err := s.InsertDocuments(userCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "tenant1",
+			Documents:      []*structpb.Struct{newDoc()},
+		})
+		require.NoError(t, err)
+
+		res, err := s.CountDocuments(userCtx, &protomodel.CountDocumentsRequest{
+			Query: &protomodel.Query{CollectionName: "tenant1"},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, res.Count)
+
+		collections, err := s.GetCollections(userCtx, &protomodel.GetCollectionsRequest{})
+		require.NoError(t, err)
+		require.Len(t, collections.Collections, 1)
+		require.Equal(t, "tenant1", collections.Collections[0].Name)
+	})
+
+	t.Run("other collections should not be accessible", func(t *testing.T) {
+		_, err := s.InsertDocuments(userCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "tenant2",
+			Documents:      []*structpb.Struct{newDoc()},
+		})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+
+		_, err = s.SearchDocuments(userCtx, &protomodel.SearchDocumentsRequest{
+			Query:    &protomodel.Query{CollectionName: "tenant2"},
+			Page:     1,
+			PageSize: 10,
+		})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+
+		_, err = s.GetCollection(userCtx, &protomodel.GetCollectionRequest{Name: "tenant2"})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+
+		// searches are resumed without a query, so the collection of the stored one is checked
+		sess, err := s.SessManager.GetSessionFromContext(userCtx)
+		require.NoError(t, err)
+
+		db, err := s.getDBFromCtx(userCtx, "SearchDocuments")
+		require.NoError(t, err)
+
+		query := &protomodel.Query{CollectionName: "tenant2"}
+
+		reader, err := db.SearchDocuments(context.Background(), query, 0, 0)
+		require.NoError(t, err)
+
+		sess.SetPaginatedDocumentReader("search1", &sessions.PaginatedDocumentReader{
+			Reader:         reader,
+			Query:          query,
+			LastPageNumber: 1,
+			LastPageSize:   10,
+		})
+
+		_, err = s.SearchDocuments(userCtx, &protomodel.SearchDocumentsRequest{
+			SearchId: "search1",
+			Page:     1,
+			PageSize: 10,
+		})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+
+		_, err = s.CreateCollection(userCtx, &protomodel.CreateCollectionRequest{Name: "tenant3"})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+
+		_, err = s.DeleteCollection(userCtx, &protomodel.DeleteCollectionRequest{Name: "tenant2"})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+	})
+
+	_, err = s.ChangeCollectionPermission(adminCtx, &schema.ChangeCollectionPermissionRequest{
+		Action:     schema.PermissionAction_GRANT,
+		Username:   "user1",
+		Database:   DefaultDBName,
+		Collection: "tenant1",
+		Permission: auth.PermissionR,
+	})
+	require.NoError(t, err)
+
+	t.Run("sessions should be closed when permissions change", func(t *testing.T) {
+		_, err := s.CountDocuments(userCtx, &protomodel.CountDocumentsRequest{
+			Query: &protomodel.Query{CollectionName: "tenant1"},
+		})
+		require.ErrorIs(t, err, sessions.ErrSessionNotFound)
+	})
+
+	userCtx = openSession("user1", "Pa$$w0rd1")
+
+	t.Run("read-only collection should not be writable", func(t *testing.T) {
+		_, err := s.CountDocuments(userCtx, &protomodel.CountDocumentsRequest{
+			Query: &protomodel.Query{CollectionName: "tenant1"},
+		})
+		require.NoError(t, err)
+
+		_, err = s.InsertDocuments(userCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "tenant1",
+			Documents:      []*structpb.Struct{newDoc()},
+		})
+		require.ErrorIs(t, err, ErrCollectionPermissionDenied)
+	})
+
+	_, err = s.ChangeCollectionPermission(adminCtx, &schema.ChangeCollectionPermissionRequest{
+		Action:     schema.PermissionAction_REVOKE,
+		Username:   "user1",
+		Database:   DefaultDBName,
+		Collection: "tenant1",
+	})
+	require.NoError(t, err)
+
+	userCtx = openSession("user1", "Pa$$w0rd1")
+
+	t.Run("database permission should apply when no collection permission is granted", func(t *testing.T) {
+		_, err := s.InsertDocuments(userCtx, &protomodel.InsertDocumentsRequest{
+			CollectionName: "tenant2",
+			Documents:      []*structpb.Struct{newDoc()},
+		})
+		require.NoError(t, err)
+
+		collections, err := s.GetCollections(userCtx, &protomodel.GetCollectionsRequest{})
+		require.NoError(t, err)
+		require.Len(t, collections.Collections, 2)
+	})
 }
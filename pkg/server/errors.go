@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
@@ -41,6 +42,7 @@ var (
 	ErrNotAllowedInMaintenanceMode = status.Error(codes.InvalidArgument, "operation not allowed in maintenance mode")
 	ErrReservedDatabase            = errors.New("database is reserved")
 	ErrPermissionDenied            = errors.New("permission denied")
+	ErrCollectionPermissionDenied  = status.Error(codes.PermissionDenied, "permission denied on collection")
 	ErrNotSupported                = errors.New("operation not supported")
 	ErrNotLoggedIn                 = auth.ErrNotLoggedIn
 	ErrReplicationInProgress       = errors.New("replication already in progress")
@@ -49,10 +51,12 @@ var (
 	ErrSessionAlreadyPresent       = errors.New("session already present").WithCode(errors.CodInternalError)
 	ErrSessionNotFound             = errors.New("session not found").WithCode(errors.CodSqlserverRejectedEstablishmentOfSqlSession)
 	ErrOngoingReadWriteTx          = sessions.ErrOngoingReadWriteTx
+	ErrTransactionExpired          = sessions.ErrTransactionExpired
 	ErrNoSessionIDPresent          = errors.New("no sessionID provided")
 	ErrTxNotProperlyClosed         = errors.New("tx not properly closed")
 	ErrReadWriteTxNotOngoing       = errors.New("read write transaction not ongoing")
 	ErrTxReadConflict              = errors.New(store.ErrTxReadConflict.Error()).WithCode(errors.CodInFailedSqlTransaction)
+	ErrSinceTxNotIndexed           = errors.New("sinceTx transaction not indexed")
 	ErrDatabaseAlreadyLoaded       = errors.New("database already loaded")
 	ErrTruncatorNotNeeded          = errors.New("truncator is not needed")
 	ErrTruncatorNotInProgress      = errors.New("truncation is not in progress")
@@ -71,9 +75,29 @@ func mapServerError(err error) error {
 	if goerrors.Is(err, store.ErrPreconditionFailed) {
 		return errors.New(err.Error()).WithCode(errors.CodIntegrityConstraintViolation)
 	}
+	for _, m := range documentErrorCodes {
+		if goerrors.Is(err, m.err) {
+			return errors.New(err.Error()).WithCode(m.code)
+		}
+	}
 	return err
 }
 
+// documentErrorCodes maps the errors of the document API to codes clients can branch on,
+// more specific errors are listed first
+var documentErrorCodes = []struct {
+	err  error
+	code errors.Code
+}{
+	{document.ErrCollectionDoesNotExist, errors.CodUndefinedTable},
+	{document.ErrCollectionAlreadyExists, errors.CodDuplicateTable},
+	{document.ErrDocumentNotFound, errors.CodNoDataFound},
+	{document.ErrDocumentAlreadyExists, errors.CodUniqueViolation},
+	{document.ErrInvalidQueryOperator, errors.CodSyntaxError},
+	{document.ErrFieldNotIndexed, errors.CodUndefinedObject},
+	{document.ErrDocumentQuotaExceeded, errors.CodProgramLimitExceeded},
+}
+
 func init() {
 	errors.CodeMap[ErrUserNotActive] = errors.CodSqlserverRejectedEstablishmentOfSqlconnection
 	errors.CodeMap[ErrInvalidUsernameOrPassword] = errors.CodSqlserverRejectedEstablishmentOfSqlconnection
@@ -21,9 +21,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/store"
 	immuerrors "github.com/codenotary/immudb/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMapServerError(t *testing.T) {
@@ -39,4 +42,28 @@ func TestMapServerError(t *testing.T) {
 
 	err = mapServerError(fmt.Errorf("%w: test", store.ErrPreconditionFailed))
 	require.Equal(t, immuerrors.CodIntegrityConstraintViolation, err.(immuerrors.Error).Code())
+
+	t.Run("document errors", func(t *testing.T) {
+		for _, c := range []struct {
+			err      error
+			code     immuerrors.Code
+			grpcCode codes.Code
+		}{
+			{document.ErrCollectionDoesNotExist, immuerrors.CodUndefinedTable, codes.NotFound},
+			{document.ErrCollectionAlreadyExists, immuerrors.CodDuplicateTable, codes.AlreadyExists},
+			{document.ErrDocumentNotFound, immuerrors.CodNoDataFound, codes.NotFound},
+			{document.ErrDocumentAlreadyExists, immuerrors.CodUniqueViolation, codes.AlreadyExists},
+			{document.ErrInvalidQueryOperator, immuerrors.CodSyntaxError, codes.InvalidArgument},
+			{document.ErrDocumentQuotaExceeded, immuerrors.CodProgramLimitExceeded, codes.ResourceExhausted},
+			{document.ErrFieldNotIndexed, immuerrors.CodUndefinedObject, codes.NotFound},
+		} {
+			err := mapServerError(fmt.Errorf("%w (mycollection)", c.err))
+			require.Equal(t, c.code, err.(immuerrors.Error).Code())
+			require.Contains(t, err.Error(), "mycollection")
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, c.grpcCode, st.Code())
+		}
+	})
 }
@@ -34,6 +34,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -387,6 +388,43 @@ var (
 	)
 )
 
+// Sessions guard metrics, updated at the end of every sweep of the sessions manager.
+var (
+	ExpiredSessionsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "expired_sessions_total",
+			Help:      "Number of sessions closed by the sessions guard after exceeding their maximum age or timeout.",
+		},
+	)
+
+	InactiveSessions = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "inactive_sessions",
+			Help:      "Number of open sessions exceeding the maximum inactivity time at the last sessions guard sweep.",
+		},
+	)
+
+	SessionsSweepDurationSeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "sessions_sweep_duration_seconds",
+			Help:      "Time taken by the sessions guard to sweep the open sessions.",
+			Buckets: []float64{
+				0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5,
+			},
+		},
+	)
+)
+
+// observeSessionsSweep records the outcome of a sweep of the sessions guard
+func observeSessionsSweep(stats *sessions.SweepStats) {
+	ExpiredSessionsTotal.Add(float64(stats.Expired))
+	InactiveSessions.Set(float64(stats.Inactive))
+	SessionsSweepDurationSeconds.Observe(stats.Duration.Seconds())
+}
+
 // QueryLatencyInterceptor returns a unary gRPC interceptor that records
 // handler duration into QueryLatencySeconds. Add to the unary chain in
 // server.go to enable per-op latency dashboards. Cardinality is bounded
@@ -412,6 +450,7 @@ func StartMetrics(
 	computeDBEntries func() map[string]float64,
 	computeLoadedDBSize func() float64,
 	computeSessionCount func() float64,
+	sessionsHealth func() SessionsHealth,
 	addPProf bool,
 ) *http.Server {
 	Metrics.WithUptimeCounter(uptimeCounter)
@@ -449,7 +488,7 @@ func StartMetrics(
 		mux.HandleFunc("/debug/pprof/trace", corsHandlerFunc(pprof.Trace))
 	}
 	mux.HandleFunc("/initz", corsHandlerFunc(ImmudbHealthHandlerFunc()))
-	mux.HandleFunc("/readyz", corsHandlerFunc(ImmudbHealthHandlerFunc()))
+	mux.HandleFunc("/readyz", corsHandlerFunc(ImmudbReadinessHandlerFunc(sessionsHealth)))
 	mux.HandleFunc("/livez", corsHandlerFunc(ImmudbHealthHandlerFunc()))
 	mux.HandleFunc("/version", corsHandlerFunc(ImmudbVersionHandlerFunc))
 
@@ -485,6 +524,27 @@ func ImmudbHealthHandlerFunc() http.HandlerFunc {
 	}
 }
 
+// SessionsHealth is the state of the session subsystem reported by the readiness probe
+type SessionsHealth struct {
+	ActiveSessions    int  `json:"activeSessions"`
+	AcceptingSessions bool `json:"acceptingSessions"`
+}
+
+// ImmudbReadinessHandlerFunc reports the instance as ready only while new sessions
+// are accepted, so traffic is not routed to draining or saturated instances
+func ImmudbReadinessHandlerFunc(sessionsHealth func() SessionsHealth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		health := sessionsHealth()
+
+		statusCode := http.StatusOK
+		if !health.AcceptingSessions {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		writeJSONResponse(w, r, statusCode, &health)
+	}
+}
+
 func ImmudbVersionHandlerFunc(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, r, 200, &Version)
 }
@@ -48,6 +48,7 @@ func TestStartMetricsHTTP(t *testing.T) {
 		func() map[string]float64 { return make(map[string]float64) },
 		func() float64 { return 1.0 },
 		func() float64 { return 2.0 },
+		func() SessionsHealth { return SessionsHealth{AcceptingSessions: true} },
 		false,
 	)
 	time.Sleep(200 * time.Millisecond)
@@ -73,6 +74,7 @@ func TestStartMetricsHTTPS(t *testing.T) {
 		func() map[string]float64 { return make(map[string]float64) },
 		func() float64 { return 1.0 },
 		func() float64 { return 2.0 },
+		func() SessionsHealth { return SessionsHealth{AcceptingSessions: true} },
 		false,
 	)
 	time.Sleep(200 * time.Millisecond)
@@ -107,6 +109,7 @@ func TestStartMetricsFail(t *testing.T) {
 		func() map[string]float64 { return make(map[string]float64) },
 		func() float64 { return 1.0 },
 		func() float64 { return 2.0 },
+		func() SessionsHealth { return SessionsHealth{AcceptingSessions: true} },
 		false,
 	)
 	time.Sleep(200 * time.Millisecond)
@@ -293,6 +296,27 @@ func TestImmudbHealthHandlerFunc(t *testing.T) {
 	require.Equal(t, http.StatusOK, rr.Code)
 }
 
+func TestImmudbReadinessHandlerFunc(t *testing.T) {
+	health := SessionsHealth{ActiveSessions: 3, AcceptingSessions: true}
+
+	handler := corsHandlerFunc(ImmudbReadinessHandlerFunc(func() SessionsHealth { return health }))
+
+	req, err := http.NewRequest("GET", "/readyz", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"activeSessions":3,"acceptingSessions":true}`, rr.Body.String())
+
+	health.AcceptingSessions = false
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	require.JSONEq(t, `{"activeSessions":3,"acceptingSessions":false}`, rr.Body.String())
+}
+
 func TestImmudbVersionHandlerFunc(t *testing.T) {
 	// test OPTIONS /version
 	req, err := http.NewRequest("OPTIONS", "/version", nil)
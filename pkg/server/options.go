@@ -28,6 +28,7 @@ import (
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/replication"
 	"github.com/codenotary/immudb/pkg/server/sessions"
+	"github.com/codenotary/immudb/pkg/signer"
 
 	"github.com/codenotary/immudb/pkg/stream"
 
@@ -37,6 +38,9 @@ import (
 const SystemDBName = "systemdb"
 const DefaultDBName = "defaultdb"
 
+// DefaultMaxDocumentsPageSize is the default maximum number of documents returned by each page of a search
+const DefaultMaxDocumentsPageSize = database.MaxKeyScanLimit
+
 // Options server options list
 type Options struct {
 	Dir                         string
@@ -70,6 +74,8 @@ type Options struct {
 	usingCustomListener         bool
 	maintenance                 bool
 	SigningKey                  string
+	PKCS11Signer                *signer.PKCS11Options
+	TimestampAuthorityURL       string
 	synced                      bool
 	RemoteStorageOptions        *RemoteStorageOptions
 	StreamChunkSize             int
@@ -86,7 +92,11 @@ type Options struct {
 	MaxActiveDatabases          int
 	AuditLog                    bool
 	AuditLogEvents              string
+	AuditLogDocumentPayloads    bool
 	MaxKeyLen                   int
+	DocumentExpirationInterval  time.Duration
+	MaxDocumentsPageSize        int
+	ClampDocumentsPageSize      bool
 }
 
 type RemoteStorageOptions struct {
@@ -172,6 +182,9 @@ func DefaultOptions() *Options {
 		AuditLog:                    false,
 		AuditLogEvents:              "all",
 		MaxKeyLen:                   0, // 0 = use embedded/sql package default
+		DocumentExpirationInterval:  1 * time.Minute,
+		MaxDocumentsPageSize:        DefaultMaxDocumentsPageSize,
+		ClampDocumentsPageSize:      false,
 	}
 }
 
@@ -380,6 +393,12 @@ func (o *Options) String() string {
 	if o.SigningKey != "" {
 		opts = append(opts, rightPad("Signing key", o.SigningKey))
 	}
+	if o.PKCS11Signer != nil {
+		opts = append(opts, rightPad("PKCS#11 signing key", fmt.Sprintf("%s/%s", o.PKCS11Signer.TokenLabel, o.PKCS11Signer.KeyLabel)))
+	}
+	if o.TimestampAuthorityURL != "" {
+		opts = append(opts, rightPad("Timestamp authority", o.TimestampAuthorityURL))
+	}
 	if o.RemoteStorageOptions.S3Storage {
 		opts = append(opts, "S3 storage")
 		if o.RemoteStorageOptions.S3RoleEnabled {
@@ -502,6 +521,21 @@ func (o *Options) WithSigningKey(signingKey string) *Options {
 	return o
 }
 
+// WithTimestampAuthorityURL sets the RFC 3161 timestamp authority used to timestamp signed states,
+// it requires either a SigningKey or a PKCS11Signer. The authority is queried synchronously by the
+// requests returning a state which was not timestamped yet, so they are slowed down by its response
+// time (up to signer.DefaultTSATimeout) and fail if no token can be obtained.
+func (o *Options) WithTimestampAuthorityURL(url string) *Options {
+	o.TimestampAuthorityURL = url
+	return o
+}
+
+// WithPKCS11Signer sets the PKCS#11 token holding the signature private key
+func (o *Options) WithPKCS11Signer(opts *signer.PKCS11Options) *Options {
+	o.PKCS11Signer = opts
+	return o
+}
+
 // WithStreamChunkSize set the chunk size
 func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	o.StreamChunkSize = streamChunkSize
@@ -587,6 +621,34 @@ func (o *Options) WithAuditLogEvents(events string) *Options {
 	return o
 }
 
+// WithAuditLogDocumentPayloads includes the documents written by document operations in their
+// audit events, by default only document ids and field names are recorded.
+func (o *Options) WithAuditLogDocumentPayloads(enabled bool) *Options {
+	o.AuditLogDocumentPayloads = enabled
+	return o
+}
+
+// WithDocumentExpirationInterval sets how often expired documents are deleted
+// from collections with a TTL. A zero interval disables the periodic deletion.
+func (o *Options) WithDocumentExpirationInterval(interval time.Duration) *Options {
+	o.DocumentExpirationInterval = interval
+	return o
+}
+
+// WithMaxDocumentsPageSize sets the maximum number of documents returned by each page of a search,
+// it's further capped by the max result size. A non-positive value leaves the max result size as the only limit.
+func (o *Options) WithMaxDocumentsPageSize(maxPageSize int) *Options {
+	o.MaxDocumentsPageSize = maxPageSize
+	return o
+}
+
+// WithClampDocumentsPageSize sets whether searches requesting a page size above the maximum
+// are served using the maximum page size instead of being rejected
+func (o *Options) WithClampDocumentsPageSize(clamp bool) *Options {
+	o.ClampDocumentsPageSize = clamp
+	return o
+}
+
 // RemoteStorageOptions
 
 func (opts *RemoteStorageOptions) WithS3Storage(S3Storage bool) *RemoteStorageOptions {
@@ -184,7 +184,18 @@ func (s *ImmuServer) Initialize() error {
 		return ErrAuthMustBeEnabled
 	}
 
-	s.SessManager, err = sessions.NewManager(s.Options.SessionsOptions)
+	sessionsOptions := *s.Options.SessionsOptions
+
+	if sweepObserver := sessionsOptions.SweepObserver; sweepObserver != nil {
+		sessionsOptions.SweepObserver = func(stats *sessions.SweepStats) {
+			observeSessionsSweep(stats)
+			sweepObserver(stats)
+		}
+	} else {
+		sessionsOptions.SweepObserver = observeSessionsSweep
+	}
+
+	s.SessManager, err = sessions.NewManager(&sessionsOptions)
 	if err != nil {
 		return err
 	}
@@ -194,6 +205,10 @@ func (s *ImmuServer) Initialize() error {
 		grpcSrvOpts = []grpc.ServerOption{grpc.Creds(credentials.NewTLS(s.Options.TLSConfig))}
 	}
 
+	if s.Options.SigningKey != "" && s.Options.PKCS11Signer != nil {
+		return logErr(s.Logger, "unable to configure the cryptographic signer: %v", fmt.Errorf("%w: a signing key (SigningKey) and a PKCS#11 signer (PKCS11Signer) can not be set together", ErrIllegalArguments))
+	}
+
 	if s.Options.SigningKey != "" {
 		if signer, err := signer.NewSigner(s.Options.SigningKey); err != nil {
 			return logErr(s.Logger, "unable to configure the cryptographic signer: %v", err)
@@ -202,6 +217,21 @@ func (s *ImmuServer) Initialize() error {
 		}
 	}
 
+	if s.Options.PKCS11Signer != nil {
+		if signer, err := signer.NewPKCS11Signer(s.Options.PKCS11Signer); err != nil {
+			return logErr(s.Logger, "unable to configure the pkcs11 cryptographic signer: %v", err)
+		} else {
+			s.StateSigner = NewStateSigner(signer)
+		}
+	}
+
+	if s.Options.TimestampAuthorityURL != "" {
+		if s.StateSigner == nil {
+			return logErr(s.Logger, "unable to configure the timestamp authority: %v", fmt.Errorf("%w: a signing key or a PKCS#11 signer is required", ErrIllegalArguments))
+		}
+		s.StateSigner = NewTimestampingStateSigner(s.StateSigner, signer.NewTSAClient(s.Options.TimestampAuthorityURL, nil))
+	}
+
 	if s.Options.usingCustomListener {
 		s.Logger.Infof("using custom listener")
 		s.Listener = s.Options.listener
@@ -349,6 +379,7 @@ func (s *ImmuServer) Start() (err error) {
 			s.metricFuncComputeDBEntries,
 			s.metricFuncComputeLoadedDBSize,
 			s.metricFuncComputeSessionCount,
+			s.sessionsHealth,
 			s.Options.PProf)
 
 		defer func() {
@@ -376,6 +407,8 @@ func (s *ImmuServer) Start() (err error) {
 	}
 	s.Logger.Infof("sessions guard started")
 
+	s.startDocumentExpiration()
+
 	if s.Options.PgsqlServer {
 		go func() {
 			s.Logger.Infof("pgsql server is running at port %d", s.Options.PgsqlServerPort)
@@ -788,6 +821,9 @@ func (s *ImmuServer) Stop() error {
 
 	s.Logger.Infof("stopping immudb:\n%v", s.Options)
 
+	// readiness probes must report the instance as not ready while stopping
+	s.SessManager.Drain()
+
 	defer func() { s.quit <- struct{}{} }()
 
 	if !s.Options.usingCustomListener {
@@ -796,6 +832,7 @@ func (s *ImmuServer) Stop() error {
 	}
 
 	s.SessManager.StopSessionsGuard()
+	s.SessManager.Close()
 
 	if s.auditLogger != nil {
 		s.auditLogger.Stop()
@@ -805,6 +842,8 @@ func (s *ImmuServer) Stop() error {
 
 	s.stopTruncation()
 
+	s.stopDocumentExpiration()
+
 	return s.CloseDatabases()
 }
 
@@ -954,13 +993,56 @@ func (s *ImmuServer) totalDBSize() (int64, error) {
 
 // Health ...
 func (s *ImmuServer) Health(ctx context.Context, _ *empty.Empty) (*schema.HealthResponse, error) {
-	return &schema.HealthResponse{Status: true, Version: Version.Version}, nil
+	sessionsHealth := s.sessionsHealth()
+
+	return &schema.HealthResponse{
+		Status:            true,
+		Version:           Version.Version,
+		AcceptingSessions: sessionsHealth.AcceptingSessions,
+		ActiveSessions:    uint32(sessionsHealth.ActiveSessions),
+	}, nil
+}
+
+// sessionsHealth reports the state of the session manager, a server without
+// session manager is not able to accept sessions
+func (s *ImmuServer) sessionsHealth() SessionsHealth {
+	if s.SessManager == nil {
+		return SessionsHealth{}
+	}
+
+	return SessionsHealth{
+		ActiveSessions:    s.SessManager.SessionCount(),
+		AcceptingSessions: s.SessManager.AcceptingSessions(),
+	}
+}
+
+// Drain stops the admission of new sessions while the server keeps serving the
+// opened ones, readiness probes report the instance as not ready from then on.
+// It's triggered by the operator with SIGUSR1 ahead of a shutdown.
+func (s *ImmuServer) Drain() {
+	if s.SessManager == nil {
+		return
+	}
+
+	s.SessManager.Drain()
 }
 
 func (s *ImmuServer) installShutdownHandler() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
+	if len(drainSignals) > 0 {
+		d := make(chan os.Signal, 1)
+		signal.Notify(d, drainSignals...)
+
+		go func() {
+			for sig := range d {
+				s.Logger.Infof("caught %v", sig)
+				s.Drain()
+			}
+		}()
+	}
+
 	go func() {
 		<-c
 		s.Logger.Infof("caught SIGTERM")
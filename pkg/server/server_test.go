@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"path/filepath"
@@ -43,6 +45,8 @@ import (
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/immuos"
+	"github.com/codenotary/immudb/pkg/server/sessions"
+	"github.com/codenotary/immudb/pkg/signer"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -1027,6 +1031,78 @@ func testServerHealth(ctx context.Context, s *ImmuServer, t *testing.T) {
 	if !h.GetStatus() {
 		t.Fatalf("Health, expected %v, got %v", true, h.GetStatus())
 	}
+
+	require.True(t, h.GetAcceptingSessions())
+	require.Equal(t, uint32(s.SessManager.SessionCount()), h.GetActiveSessions())
+}
+
+func TestServerHealthWhileDraining(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	h, err := s.Health(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.True(t, h.GetStatus())
+	require.True(t, h.GetAcceptingSessions())
+	require.Zero(t, h.GetActiveSessions())
+
+	s.SessManager.Drain()
+
+	h, err = s.Health(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.True(t, h.GetStatus())
+	require.False(t, h.GetAcceptingSessions())
+}
+
+func TestServerDrainKeepsServingSessions(t *testing.T) {
+	s, closer := testServer(DefaultOptions().WithDir(t.TempDir()))
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	readyz := ImmudbReadinessHandlerFunc(s.sessionsHealth)
+
+	rr := httptest.NewRecorder()
+	readyz(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	s.Drain()
+
+	rr = httptest.NewRecorder()
+	readyz(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	require.JSONEq(t, `{"activeSessions":1,"acceptingSessions":false}`, rr.Body.String())
+
+	// the opened session is still served
+	_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	entry, err := s.Get(ctx, &schema.KeyRequest{Key: []byte("key1")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), entry.Value)
+
+	_, err = s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.ErrorIs(t, err, sessions.ErrSessionsDraining)
+
+	_, err = s.CloseSession(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
 }
 
 func testServerHealthError(ctx context.Context, s *ImmuServer, t *testing.T) {
@@ -1249,6 +1325,74 @@ func testServerCount(ctx context.Context, s *ImmuServer, t *testing.T) {
 	}
 }
 
+func TestServerPKCS11SignerOptions(t *testing.T) {
+	pkcs11Opts := &signer.PKCS11Options{
+		ModulePath: "./not_exists.so",
+		TokenLabel: "immudb",
+		KeyLabel:   "state-signing",
+	}
+
+	t.Run("signing key and pkcs11 signer are mutually exclusive", func(t *testing.T) {
+		serverOptions := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithMetricsServer(false).
+			WithSigningKey("./../../test/signer/ec1.key").
+			WithPKCS11Signer(pkcs11Opts)
+
+		s, closer := testServer(serverOptions)
+		defer closer()
+
+		err := s.Initialize()
+		require.ErrorIs(t, err, ErrIllegalArguments)
+		require.ErrorContains(t, err, "SigningKey")
+		require.ErrorContains(t, err, "PKCS11Signer")
+	})
+
+	t.Run("pkcs11 signer initialization failure", func(t *testing.T) {
+		serverOptions := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithMetricsServer(false).
+			WithPKCS11Signer(pkcs11Opts)
+
+		s, closer := testServer(serverOptions)
+		defer closer()
+
+		err := s.Initialize()
+		require.Error(t, err)
+		require.Nil(t, s.StateSigner)
+	})
+}
+
+func TestServerTimestampAuthorityOptions(t *testing.T) {
+	t.Run("timestamp authority requires a signing key", func(t *testing.T) {
+		serverOptions := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithMetricsServer(false).
+			WithTimestampAuthorityURL("http://localhost:3161")
+
+		s, closer := testServer(serverOptions)
+		defer closer()
+
+		err := s.Initialize()
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("timestamp authority with signing key", func(t *testing.T) {
+		serverOptions := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithMetricsServer(false).
+			WithSigningKey("./../../test/signer/ec1.key").
+			WithTimestampAuthorityURL("http://localhost:3161")
+
+		s, closer := testServer(serverOptions)
+		defer closer()
+
+		err := s.Initialize()
+		require.NoError(t, err)
+		require.IsType(t, &timestampingStateSigner{}, s.StateSigner)
+	})
+}
+
 func TestServerDbOperations(t *testing.T) {
 	serverOptions := DefaultOptions().
 		WithDir(t.TempDir()).
@@ -44,7 +44,7 @@ type ServerMock struct {
 	GetDbIndexFromCtx func(context.Context, string) (int64, error)
 }
 
-func (s *ServerMock) TxSQLExec(ctx context.Context, request *schema.SQLExecRequest) (*empty.Empty, error) {
+func (s *ServerMock) TxSQLExec(ctx context.Context, request *schema.SQLExecRequest) (*schema.TxSQLExecResult, error) {
 	return s.Srv.TxSQLExec(ctx, request)
 }
 
@@ -391,6 +391,10 @@ func (s *ServerMock) TruncateDatabase(ctx context.Context, req *schema.TruncateD
 	return s.Srv.TruncateDatabase(ctx, req)
 }
 
+func (s *ServerMock) ChangeCollectionPermission(ctx context.Context, r *schema.ChangeCollectionPermissionRequest) (*empty.Empty, error) {
+	return s.Srv.ChangeCollectionPermission(ctx, r)
+}
+
 func (s *ServerMock) ChangeSQLPrivileges(ctx context.Context, r *schema.ChangeSQLPrivilegesRequest) (*schema.ChangeSQLPrivilegesResponse, error) {
 	return nil, nil
 }
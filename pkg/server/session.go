@@ -66,7 +66,13 @@ func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionReque
 		return nil, status.Errorf(codes.PermissionDenied, "Logged in user does not have permission on this database")
 	}
 
-	session, err := s.SessManager.NewSession(u, db)
+	var session *sessions.Session
+
+	if r.SessionID != "" {
+		session, err = s.SessManager.ResumeSession(r.SessionID, u, db)
+	} else {
+		session, err = s.SessManager.NewSession(u, db)
+	}
 	if err != nil {
 		return nil, err
 	}
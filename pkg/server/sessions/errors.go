@@ -18,6 +18,7 @@ package sessions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/errors"
 	"github.com/codenotary/immudb/pkg/server/sessions/internal/transactions"
@@ -27,15 +28,47 @@ var ErrSessionAlreadyPresent = errors.New("session already present").WithCode(er
 var ErrNoSessionIDPresent = errors.New("no sessionID provided").WithCode(errors.CodInvalidAuthorizationSpecification)
 var ErrNoSessionAuthDataProvided = errors.New("no session auth data provided").WithCode(errors.CodInvalidAuthorizationSpecification)
 var ErrSessionNotFound = errors.New("no session found").WithCode(errors.CodInvalidParameterValue)
+var ErrSessionDatabaseMismatch = errors.New("session was opened against a different database").WithCode(errors.CodInvalidParameterValue)
 var ErrOngoingReadWriteTx = errors.New("only 1 read write transaction supported at once").WithCode(errors.CodSqlserverRejectedEstablishmentOfSqlSession)
 var ErrNoTransactionIDPresent = errors.New("no transactionID provided").WithCode(errors.CodInvalidAuthorizationSpecification)
 var ErrNoTransactionAuthDataProvided = errors.New("no transaction auth data provided").WithCode(errors.CodInvalidAuthorizationSpecification)
 var ErrInvalidOptionsProvided = errors.New("invalid options provided")
 var ErrTransactionNotFound = transactions.ErrTransactionNotFound
+var ErrTxConflict = transactions.ErrTxConflict
+var ErrTransactionNotOwned = errors.New("transaction belongs to a different session").WithCode(errors.CodInvalidAuthorizationSpecification)
+var ErrTransactionExpired = errors.New("transaction expired and was rolled back").WithCode(errors.CodInFailedSqlTransaction)
+var ErrPreparedStatementNotFound = errors.New("prepared statement not found").WithCode(errors.CodInvalidParameterValue)
+var ErrTransactionAlreadyPresent = errors.New("transaction already present").WithCode(errors.CodInternalError)
 var ErrGuardAlreadyRunning = errors.New("session guard already launched")
 var ErrGuardNotRunning = errors.New("session guard not running")
 var ErrCantCreateSession = errors.New("can not create new session")
 var ErrMaxSessionsReached = fmt.Errorf("%w: max sessions number reached", ErrCantCreateSession)
+var ErrSessionsDraining = fmt.Errorf("%w: session manager is draining", ErrCantCreateSession)
 var ErrCantCreateSessionID = fmt.Errorf("%w: generation of session id failed", ErrCantCreateSession)
+var ErrNotEnoughRandomData = errors.New("could not produce enough random data")
+var ErrCantCreateTransactionID = errors.New("generation of transaction id failed")
 var ErrWriteOnlyTXNotAllowed = errors.New("write only transaction not allowed")
 var ErrReadOnlyTXNotAllowed = errors.New("read only transaction not allowed")
+var ErrReadWriteTXNotAllowed = errors.New("read write transaction not allowed in a read-only session").WithCode(errors.CodSqlserverRejectedEstablishmentOfSqlSession)
+
+// OngoingReadWriteTxError is returned instead of ErrOngoingReadWriteTx to report
+// which read-write transaction prevented a new one from being created.
+type OngoingReadWriteTxError struct {
+	TransactionID string
+	CreatedAt     time.Time
+	// Database is set when the transaction is held by another session on the same database
+	Database string
+}
+
+func (e *OngoingReadWriteTxError) Error() string {
+	if e.Database != "" {
+		return fmt.Sprintf("%s: another session holds the write transaction on database %s (transaction %s created at %s)",
+			ErrOngoingReadWriteTx.Error(), e.Database, e.TransactionID, e.CreatedAt.Format(time.RFC3339Nano))
+	}
+	return fmt.Sprintf("%s: transaction %s created at %s is still ongoing",
+		ErrOngoingReadWriteTx.Error(), e.TransactionID, e.CreatedAt.Format(time.RFC3339Nano))
+}
+
+func (e *OngoingReadWriteTxError) Unwrap() error {
+	return ErrOngoingReadWriteTx
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/server/sessions/internal/transactions"
+)
+
+// DefaultTransactionEventsBufferSize is the default number of transaction events
+// queued while waiting to be delivered to the observer
+const DefaultTransactionEventsBufferSize = 1000
+
+// TransactionEvent describes the outcome of a session transaction
+type TransactionEvent struct {
+	TransactionID string
+	SessionID     string
+	Database      string
+	ReadOnly      bool
+	Committed     bool
+	// TxHeader is the header of the committed transaction,
+	// nil if the transaction was rolled back or it did not write anything
+	TxHeader *schema.TxHeader
+}
+
+// TransactionObserver is notified once a session transaction is committed or rolled back,
+// including the failed commits and the rollbacks done by the server on expired transactions
+// and closed sessions.
+// Events are delivered in order by a single goroutine, outside of any session lock.
+type TransactionObserver func(event *TransactionEvent)
+
+func newTransactionEvent(tx transactions.Transaction, committed bool, committedTxs []*sql.SQLTx) *TransactionEvent {
+	event := &TransactionEvent{
+		TransactionID: tx.GetID(),
+		SessionID:     tx.GetSessionID(),
+		Database:      tx.Database().GetName(),
+		ReadOnly:      tx.IsReadOnly(),
+		Committed:     committed,
+	}
+
+	if hdr := lastTxHeader(committedTxs); hdr != nil {
+		event.TxHeader = schema.TxHeaderToProto(hdr)
+	}
+
+	return event
+}
+
+// lastTxHeader returns the header of the latest transaction written by the
+// committed SQL transactions, nil if none of them wrote anything
+func lastTxHeader(committedTxs []*sql.SQLTx) *store.TxHeader {
+	for i := len(committedTxs) - 1; i >= 0; i-- {
+		if hdr := committedTxs[i].TxHeader(); hdr != nil {
+			return hdr
+		}
+	}
+	return nil
+}
+
+// notifyTransactionEvent queues the event for delivery without blocking, so a slow
+// observer never stalls the committing path. Events exceeding the buffer are dropped.
+func (sm *manager) notifyTransactionEvent(event *TransactionEvent) {
+	if sm.txEvents == nil {
+		return
+	}
+
+	select {
+	case sm.txEvents <- event:
+	default:
+		sm.logger.Warningf("transaction events buffer is full, event of transaction %s was dropped", event.TransactionID)
+	}
+}
+
+// dispatchTransactionEvents delivers the queued events to the observer, it runs
+// until the manager is closed
+func (sm *manager) dispatchTransactionEvents() {
+	defer close(sm.txEventsStopped)
+
+	for {
+		select {
+		case event := <-sm.txEvents:
+			sm.options.TransactionObserver(event)
+		case flushed := <-sm.txEventsFlush:
+			sm.deliverPendingTransactionEvents()
+			close(flushed)
+		case <-sm.txEventsDone:
+			sm.deliverPendingTransactionEvents()
+			return
+		}
+	}
+}
+
+func (sm *manager) deliverPendingTransactionEvents() {
+	for {
+		select {
+		case event := <-sm.txEvents:
+			sm.options.TransactionObserver(event)
+		default:
+			return
+		}
+	}
+}
+
+// flushTransactionEvents waits until the events queued so far are delivered to the observer
+func (sm *manager) flushTransactionEvents() {
+	if sm.txEvents == nil {
+		return
+	}
+
+	flushed := make(chan struct{})
+
+	select {
+	case sm.txEventsFlush <- flushed:
+		<-flushed
+	case <-sm.txEventsStopped:
+		// pending events were delivered when the manager was closed
+	}
+}
+
+// stopTransactionEvents makes the dispatcher deliver the queued events and return,
+// events notified afterwards are not delivered
+func (sm *manager) stopTransactionEvents() {
+	if sm.txEvents == nil {
+		return
+	}
+
+	sm.txEventsDoneOnce.Do(func() { close(sm.txEventsDone) })
+	<-sm.txEventsStopped
+}
+
+// SweepStats summarizes a run of the sessions guard
+type SweepStats struct {
+	// Open is the number of sessions still open after the sweep
+	Open int
+	// Inactive is the number of open sessions exceeding MaxSessionInactivityTime
+	Inactive int
+	// Expired is the number of sessions closed by the sweep because they exceeded
+	// MaxSessionAgeTime or Timeout
+	Expired int
+	// Duration is the time taken by the sweep
+	Duration time.Duration
+}
+
+// SweepObserver is notified at the end of every run of the sessions guard.
+// It's called once the transactions of the expired sessions have been rolled back,
+// from the guard goroutine, so it should not block.
+type SweepObserver func(stats *SweepStats)
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import "time"
+
+// SessionStatus is the state of a session reported back on heartbeats
+type SessionStatus int
+
+const (
+	// SessionActive sessions are served normally
+	SessionActive SessionStatus = iota
+	// SessionDraining sessions are still served but the server no longer accepts
+	// new sessions, clients should be prepared to reconnect elsewhere
+	SessionDraining
+)
+
+// Heartbeat is the outcome of a session heartbeat
+type Heartbeat struct {
+	Status SessionStatus
+	// Deadline is the time after which the sessions guard removes the session
+	// unless a new heartbeat is received, zero if the session never expires
+	Deadline time.Time
+	// InactiveAt is the time after which the session is declared inactive, zero if never
+	InactiveAt time.Time
+}
+
+// Heartbeat records activity on the session and returns the deadlines the sessions guard
+// will enforce from now on
+func (sm *manager) Heartbeat(sessionID string) (*Heartbeat, error) {
+	sess, err := sm.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sess.SetLastActivityTime(now)
+
+	status := SessionActive
+	if sm.draining.Load() {
+		status = SessionDraining
+	}
+
+	return &Heartbeat{
+		Status:     status,
+		Deadline:   sm.sessionDeadline(sess.GetCreationTime(), now),
+		InactiveAt: deadlineAfter(now, sm.options.MaxSessionInactivityTime),
+	}, nil
+}
+
+// sessionDeadline returns the time after which expireSessions removes a session
+// with the given creation and last activity times, zero if it never does
+func (sm *manager) sessionDeadline(createdAt, lastActivity time.Time) time.Time {
+	ageDeadline := deadlineAfter(createdAt, sm.options.MaxSessionAgeTime)
+	timeoutDeadline := deadlineAfter(lastActivity, sm.options.Timeout)
+
+	if ageDeadline.IsZero() || (!timeoutDeadline.IsZero() && timeoutDeadline.Before(ageDeadline)) {
+		return timeoutDeadline
+	}
+	return ageDeadline
+}
+
+func deadlineAfter(t time.Time, d time.Duration) time.Time {
+	if d == infinity {
+		return time.Time{}
+	}
+	return t.Add(d)
+}
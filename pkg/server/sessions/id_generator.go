@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"encoding/base64"
+	"io"
+	"sync"
+
+	"github.com/rs/xid"
+)
+
+// sessionIDRandomBytes is the amount of random data encoded in a session ID
+const sessionIDRandomBytes = 32
+
+// IDGenerator produces the identifiers assigned to sessions and transactions.
+// Implementations must be safe for concurrent use.
+type IDGenerator interface {
+	NewSessionID() (string, error)
+	NewTransactionID() (string, error)
+}
+
+// defaultIDGenerator generates session IDs as base64-encoded random tokens
+// read from a random source, and transaction IDs as xids.
+type defaultIDGenerator struct {
+	// randMux serializes reads, the random source is not required to be
+	// safe for concurrent use
+	randMux    sync.Mutex
+	randSource io.Reader
+}
+
+// NewDefaultIDGenerator returns the IDGenerator used when none is provided in
+// the options, session IDs are built from data read from randSource.
+func NewDefaultIDGenerator(randSource io.Reader) IDGenerator {
+	return &defaultIDGenerator{randSource: randSource}
+}
+
+func (g *defaultIDGenerator) NewSessionID() (string, error) {
+	randomBytes := make([]byte, sessionIDRandomBytes)
+
+	g.randMux.Lock()
+	n, err := g.randSource.Read(randomBytes)
+	g.randMux.Unlock()
+
+	if err != nil {
+		return "", err
+	}
+	if n < len(randomBytes) {
+		return "", ErrNotEnoughRandomData
+	}
+
+	return base64.URLEncoding.EncodeToString(randomBytes), nil
+}
+
+func (g *defaultIDGenerator) NewTransactionID() (string, error) {
+	return xid.New().String(), nil
+}
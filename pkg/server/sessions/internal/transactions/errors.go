@@ -18,4 +18,11 @@ package transactions
 
 import "github.com/codenotary/immudb/pkg/errors"
 
+// txConflictRetryDelay is the delay in milliseconds suggested to clients before re-running a conflicting transaction
+const txConflictRetryDelay = 10
+
 var ErrTransactionNotFound = errors.New("no transaction found").WithCode(errors.CodInvalidParameterValue)
+
+// ErrTxConflict is returned when a transaction can not be committed because data it read was updated
+// by a concurrently committed transaction. The transaction can be run again from the beginning.
+var ErrTxConflict = errors.New("transaction conflict").WithCode(errors.CodSerializationFailure).WithRetryDelay(txConflictRetryDelay)
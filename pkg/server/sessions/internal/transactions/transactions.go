@@ -18,12 +18,16 @@ package transactions
 
 import (
 	"context"
+	goerrors "errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/database"
-	"github.com/rs/xid"
+	"github.com/codenotary/immudb/pkg/errors"
 )
 
 type transaction struct {
@@ -32,26 +36,44 @@ type transaction struct {
 	sqlTx         *sql.SQLTx
 	db            database.DB
 	sessionID     string
+	createdAt     time.Time
+	readOnly      bool
+	opts          *sql.TxOptions
+	// mustIncludeTxID is the latest transaction the snapshot of a
+	// read-only transaction is required to include
+	mustIncludeTxID uint64
+}
+
+// ExecResult holds the effects of the statements run by a single SQLExec call
+type ExecResult struct {
+	// UpdatedRows is the number of rows inserted, updated or deleted
+	UpdatedRows int
+	// LastInsertedPKs holds the last auto-incremented primary key inserted into each table
+	LastInsertedPKs map[string]int64
 }
 
 type Transaction interface {
 	GetID() string
+	CreatedAt() time.Time
+	IsReadOnly() bool
 	IsClosed() bool
 	Rollback() error
 	Commit(ctx context.Context) ([]*sql.SQLTx, error)
 	GetSessionID() string
+	SnapshotTxID() (uint64, error)
+	SetSnapshotMustIncludeTxID(txID uint64)
 	Database() database.DB
-	SQLExec(ctx context.Context, request *schema.SQLExecRequest) error
+	SQLExec(ctx context.Context, request *schema.SQLExecRequest) (*ExecResult, error)
 	SQLQuery(ctx context.Context, request *schema.SQLQueryRequest) (sql.RowReader, error)
+	SQLExecPrepared(ctx context.Context, stmts []sql.SQLStmt, params map[string]interface{}) (*ExecResult, error)
+	SQLQueryPrepared(ctx context.Context, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error)
 }
 
-func NewTransaction(ctx context.Context, opts *sql.TxOptions, db database.DB, sessionID string) (*transaction, error) {
-	if opts == nil {
+func NewTransaction(ctx context.Context, transactionID string, opts *sql.TxOptions, db database.DB, sessionID string) (*transaction, error) {
+	if transactionID == "" || opts == nil {
 		return nil, sql.ErrIllegalArguments
 	}
 
-	transactionID := xid.New().String()
-
 	sqlTx, err := db.NewSQLTx(ctx, opts.WithExplicitClose(true))
 	if err != nil {
 		return nil, err
@@ -62,6 +84,9 @@ func NewTransaction(ctx context.Context, opts *sql.TxOptions, db database.DB, se
 		transactionID: transactionID,
 		db:            db,
 		sessionID:     sessionID,
+		createdAt:     time.Now(),
+		readOnly:      opts.ReadOnly,
+		opts:          opts,
 	}, nil
 }
 
@@ -72,6 +97,20 @@ func (tx *transaction) GetID() string {
 	return tx.transactionID
 }
 
+func (tx *transaction) CreatedAt() time.Time {
+	tx.mutex.RLock()
+	defer tx.mutex.RUnlock()
+
+	return tx.createdAt
+}
+
+func (tx *transaction) IsReadOnly() bool {
+	tx.mutex.RLock()
+	defer tx.mutex.RUnlock()
+
+	return tx.readOnly
+}
+
 func (tx *transaction) IsClosed() bool {
 	tx.mutex.RLock()
 	defer tx.mutex.RUnlock()
@@ -99,6 +138,13 @@ func (tx *transaction) Commit(ctx context.Context) ([]*sql.SQLTx, error) {
 	}
 
 	_, cTxs, err := tx.db.SQLExec(ctx, tx.sqlTx, &schema.SQLExecRequest{Sql: "COMMIT;"})
+	if goerrors.Is(err, store.ErrTxReadConflict) {
+		// the cause is kept in the message and in the error chain while the code identifies the conflict
+		return nil, errors.New(fmt.Sprintf("%s: %v", ErrTxConflict.Message(), err)).
+			WithCode(ErrTxConflict.Code()).
+			WithRetryDelay(ErrTxConflict.RetryDelay()).
+			WithWrapped(err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -113,17 +159,85 @@ func (tx *transaction) GetSessionID() string {
 	return tx.sessionID
 }
 
-func (tx *transaction) SQLExec(ctx context.Context, request *schema.SQLExecRequest) (err error) {
+// NewExecResult returns the effects of the statements run in the given SQL transactions,
+// as done when statements are run without an explicit transaction
+func NewExecResult(sqlTxs []*sql.SQLTx) *ExecResult {
+	res := &ExecResult{LastInsertedPKs: make(map[string]int64)}
+
+	for _, sqlTx := range sqlTxs {
+		res.UpdatedRows += sqlTx.UpdatedRows()
+
+		for table, pk := range sqlTx.LastInsertedPKs() {
+			res.LastInsertedPKs[table] = pk
+		}
+	}
+
+	return res
+}
+
+func (tx *transaction) SQLExec(ctx context.Context, request *schema.SQLExecRequest) (*ExecResult, error) {
+	return tx.exec(func(sqlTx *sql.SQLTx) (*sql.SQLTx, []*sql.SQLTx, error) {
+		return tx.db.SQLExec(ctx, sqlTx, request)
+	})
+}
+
+// SQLExecPrepared runs statements which have already been parsed
+func (tx *transaction) SQLExecPrepared(ctx context.Context, stmts []sql.SQLStmt, params map[string]interface{}) (*ExecResult, error) {
+	return tx.exec(func(sqlTx *sql.SQLTx) (*sql.SQLTx, []*sql.SQLTx, error) {
+		return tx.db.SQLExecPrepared(ctx, sqlTx, stmts, params)
+	})
+}
+
+func (tx *transaction) exec(execFn func(sqlTx *sql.SQLTx) (*sql.SQLTx, []*sql.SQLTx, error)) (*ExecResult, error) {
 	tx.mutex.Lock()
 	defer tx.mutex.Unlock()
 
 	if tx.sqlTx == nil || tx.sqlTx.Closed() {
-		return sql.ErrNoOngoingTx
+		return nil, sql.ErrNoOngoingTx
+	}
+
+	// the counters of the SQL transaction include the effects of the previous statements
+	prevTx := tx.sqlTx
+	prevUpdatedRows := prevTx.UpdatedRows()
+
+	prevLastInsertedPKs := make(map[string]int64, len(prevTx.LastInsertedPKs()))
+	for table, pk := range prevTx.LastInsertedPKs() {
+		prevLastInsertedPKs[table] = pk
+	}
+
+	ntx, committedTxs, err := execFn(tx.sqlTx)
+	tx.sqlTx = ntx
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ExecResult{LastInsertedPKs: make(map[string]int64)}
+
+	sqlTxs := committedTxs
+	if ntx != nil && (len(committedTxs) == 0 || committedTxs[len(committedTxs)-1] != ntx) {
+		sqlTxs = append(sqlTxs, ntx)
 	}
 
-	tx.sqlTx, _, err = tx.db.SQLExec(ctx, tx.sqlTx, request)
+	for _, sqlTx := range sqlTxs {
+		if sqlTx != prevTx {
+			res.UpdatedRows += sqlTx.UpdatedRows()
+
+			for table, pk := range sqlTx.LastInsertedPKs() {
+				res.LastInsertedPKs[table] = pk
+			}
+			continue
+		}
+
+		res.UpdatedRows += sqlTx.UpdatedRows() - prevUpdatedRows
+
+		for table, pk := range sqlTx.LastInsertedPKs() {
+			if prevPK, ok := prevLastInsertedPKs[table]; !ok || prevPK != pk {
+				res.LastInsertedPKs[table] = pk
+			}
+		}
+	}
 
-	return err
+	return res, nil
 }
 
 func (tx *transaction) SQLQuery(ctx context.Context, request *schema.SQLQueryRequest) (sql.RowReader, error) {
@@ -134,9 +248,101 @@ func (tx *transaction) SQLQuery(ctx context.Context, request *schema.SQLQueryReq
 		return nil, sql.ErrNoOngoingTx
 	}
 
+	err := tx.renewSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return tx.db.SQLQuery(ctx, tx.sqlTx, request)
 }
 
+// SQLQueryPrepared runs a query which has already been parsed
+func (tx *transaction) SQLQueryPrepared(ctx context.Context, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+
+	if tx.sqlTx == nil || tx.sqlTx.Closed() {
+		return nil, sql.ErrNoOngoingTx
+	}
+
+	err := tx.renewSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.db.SQLQueryPrepared(ctx, tx.sqlTx, stmt, params)
+}
+
+// SnapshotTxID returns the ID of the latest transaction visible within the transaction.
+// The snapshot of a read-only transaction may be renewed by its next query, see SetSnapshotMustIncludeTxID.
+func (tx *transaction) SnapshotTxID() (uint64, error) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+
+	if tx.sqlTx == nil || tx.sqlTx.Closed() {
+		return 0, sql.ErrNoOngoingTx
+	}
+
+	return tx.sqlTx.SnapshotTxID()
+}
+
+// SetSnapshotMustIncludeTxID requires the following queries of a read-only transaction
+// to observe the given transaction, its snapshot is renewed on the next query when
+// it's older. Read-write transactions are not affected.
+func (tx *transaction) SetSnapshotMustIncludeTxID(txID uint64) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+
+	if tx.readOnly && txID > tx.mustIncludeTxID {
+		tx.mustIncludeTxID = txID
+	}
+}
+
+// not thread safe
+// renewSnapshot replaces the SQL transaction of a read-only transaction whose snapshot
+// does not include mustIncludeTxID. A read-only transaction holds no pending
+// writes, so it's reopened without losing anything.
+func (tx *transaction) renewSnapshot(ctx context.Context) error {
+	if !tx.readOnly || tx.mustIncludeTxID == 0 {
+		return nil
+	}
+
+	snapshotTxID, err := tx.sqlTx.SnapshotTxID()
+	if err != nil {
+		return err
+	}
+
+	if snapshotTxID >= tx.mustIncludeTxID {
+		return nil
+	}
+
+	mustIncludeTxID := tx.mustIncludeTxID
+	snapshotMustIncludeTxID := tx.opts.SnapshotMustIncludeTxID
+
+	opts := *tx.opts
+	opts.WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 {
+		if snapshotMustIncludeTxID != nil {
+			return max(snapshotMustIncludeTxID(lastPrecommittedTxID), mustIncludeTxID)
+		}
+		return mustIncludeTxID
+	})
+
+	sqlTx, err := tx.db.NewSQLTx(ctx, &opts)
+	if err != nil {
+		return err
+	}
+
+	err = tx.sqlTx.Cancel()
+	if err != nil {
+		sqlTx.Cancel()
+		return err
+	}
+
+	tx.sqlTx = sqlTx
+
+	return nil
+}
+
 func (tx *transaction) Database() database.DB {
 	return tx.db
 }
@@ -20,10 +20,14 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,20 +37,35 @@ func TestNewTx(t *testing.T) {
 	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(path), logger.NewSimpleLogger("logger", os.Stdout))
 	require.NoError(t, err)
 
-	_, err = NewTransaction(context.Background(), nil, db, "session1")
+	_, err = NewTransaction(context.Background(), "tx1", nil, db, "session1")
 	require.ErrorIs(t, err, sql.ErrIllegalArguments)
 
-	tx, err := NewTransaction(context.Background(), sql.DefaultTxOptions(), db, "session1")
+	_, err = NewTransaction(context.Background(), "", sql.DefaultTxOptions(), db, "session1")
+	require.ErrorIs(t, err, sql.ErrIllegalArguments)
+
+	tx, err := NewTransaction(context.Background(), "tx1", sql.DefaultTxOptions(), db, "session1")
 	require.NoError(t, err)
 	require.NotNil(t, tx)
+	require.Equal(t, "tx1", tx.GetID())
+	require.WithinDuration(t, time.Now(), tx.CreatedAt(), time.Minute)
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+
+	snapshotTxID, err := tx.SnapshotTxID()
+	require.NoError(t, err)
+	require.Equal(t, state.TxId, snapshotTxID)
 
 	err = tx.Rollback()
 	require.NoError(t, err)
 
+	_, err = tx.SnapshotTxID()
+	require.ErrorIs(t, err, sql.ErrNoOngoingTx)
+
 	_, err = tx.SQLQuery(context.Background(), nil)
 	require.ErrorIs(t, err, sql.ErrNoOngoingTx)
 
-	err = tx.SQLExec(context.Background(), nil)
+	_, err = tx.SQLExec(context.Background(), nil)
 	require.ErrorIs(t, err, sql.ErrNoOngoingTx)
 
 	err = tx.Rollback()
@@ -55,3 +74,95 @@ func TestNewTx(t *testing.T) {
 	_, err = tx.Commit(context.Background())
 	require.ErrorIs(t, err, sql.ErrNoOngoingTx)
 }
+
+func TestSQLExecResult(t *testing.T) {
+	path := t.TempDir()
+
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(path), logger.NewSimpleLogger("logger", os.Stdout))
+	require.NoError(t, err)
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+		Sql: "CREATE TABLE t1(id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id)",
+	})
+	require.NoError(t, err)
+
+	tx, err := NewTransaction(context.Background(), "tx1", sql.DefaultTxOptions(), db, "session1")
+	require.NoError(t, err)
+
+	res, err := tx.SQLExec(context.Background(), &schema.SQLExecRequest{
+		Sql: "INSERT INTO t1(name) VALUES ('a'), ('b'), ('c')",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, res.UpdatedRows)
+	require.Equal(t, map[string]int64{"t1": 3}, res.LastInsertedPKs)
+
+	res, err = tx.SQLExec(context.Background(), &schema.SQLExecRequest{
+		Sql: "UPDATE t1 SET name = 'z' WHERE id > 1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, res.UpdatedRows)
+	require.Empty(t, res.LastInsertedPKs)
+
+	res, err = tx.SQLExec(context.Background(), &schema.SQLExecRequest{
+		Sql: "DELETE FROM t1 WHERE id = 1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.UpdatedRows)
+	require.Empty(t, res.LastInsertedPKs)
+
+	res, err = tx.SQLExec(context.Background(), &schema.SQLExecRequest{
+		Sql: "INSERT INTO t1(name) VALUES ('d')",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.UpdatedRows)
+	require.Equal(t, map[string]int64{"t1": 4}, res.LastInsertedPKs)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+}
+
+func TestCommitConflict(t *testing.T) {
+	path := t.TempDir()
+
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(path), logger.NewSimpleLogger("logger", os.Stdout))
+	require.NoError(t, err)
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+		Sql: "CREATE TABLE t1(id INTEGER, name VARCHAR, PRIMARY KEY id); INSERT INTO t1(id, name) VALUES (1, 'a')",
+	})
+	require.NoError(t, err)
+
+	tx1, err := NewTransaction(context.Background(), "tx1", sql.DefaultTxOptions(), db, "session1")
+	require.NoError(t, err)
+
+	tx2, err := NewTransaction(context.Background(), "tx2", sql.DefaultTxOptions(), db, "session2")
+	require.NoError(t, err)
+
+	_, err = tx1.SQLExec(context.Background(), &schema.SQLExecRequest{Sql: "UPDATE t1 SET name = 'b' WHERE id = 1"})
+	require.NoError(t, err)
+
+	_, err = tx2.SQLExec(context.Background(), &schema.SQLExecRequest{Sql: "UPDATE t1 SET name = 'c' WHERE id = 1"})
+	require.NoError(t, err)
+
+	_, err = tx2.Commit(context.Background())
+	require.NoError(t, err)
+
+	_, err = tx1.Commit(context.Background())
+	require.ErrorIs(t, err, ErrTxConflict)
+	require.Contains(t, err.Error(), store.ErrTxReadConflict.Error())
+	require.ErrorIs(t, err, store.ErrTxReadConflict)
+
+	immuErr, ok := err.(errors.Error)
+	require.True(t, ok)
+	require.Equal(t, errors.CodSerializationFailure, immuErr.Code())
+	require.Positive(t, immuErr.RetryDelay())
+
+	t.Run("constraint violations should not be reported as conflicts", func(t *testing.T) {
+		tx, err := NewTransaction(context.Background(), "tx3", sql.DefaultTxOptions(), db, "session1")
+		require.NoError(t, err)
+
+		_, err = tx.SQLExec(context.Background(), &schema.SQLExecRequest{Sql: "INSERT INTO t1(id, name) VALUES (1, 'd')"})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrTxConflict)
+	})
+}
@@ -18,7 +18,7 @@ package sessions
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"hash/fnv"
 	"math"
 	"os"
@@ -68,20 +68,51 @@ type manager struct {
 	// admission check in NewSession do not need to lock all shards.
 	sessionsCount atomic.Int64
 
+	// draining is set once the manager stops admitting new sessions, so
+	// health probes can report the instance as not ready while the
+	// existing sessions are still being served.
+	draining atomic.Bool
+
+	// txEvents queues the transaction events to be delivered to the
+	// observer, nil if no observer is registered
+	txEvents chan *TransactionEvent
+	// txEventsFlush requests the dispatcher to deliver the queued events,
+	// the channel sent is closed once they have been delivered
+	txEventsFlush chan chan struct{}
+	// txEventsDone is closed by Close to stop the dispatcher, which closes
+	// txEventsStopped once the pending events have been delivered
+	txEventsDone     chan struct{}
+	txEventsDoneOnce sync.Once
+	txEventsStopped  chan struct{}
+
+	// rwSlots tracks the read-write transaction held on each database,
+	// nil unless ExclusiveReadWriteTx is enabled
+	rwSlots *readWriteSlots
+
+	// txOwners indexes the open transactions by ID to the session holding them
+	txOwners *transactionOwners
+
 	logger  logger.Logger
 	options Options
 }
 
 type Manager interface {
 	NewSession(user *auth.User, db database.DB) (*Session, error)
+	ResumeSession(sessionID string, user *auth.User, db database.DB) (*Session, error)
+	DeriveReadOnlySession(parentID string) (*Session, error)
 	SessionPresent(sessionID string) bool
 	DeleteSession(sessionID string) error
 	UpdateSessionActivityTime(sessionID string)
+	Heartbeat(sessionID string) (*Heartbeat, error)
 	StartSessionsGuard() error
 	StopSessionsGuard() error
+	Close() error
 	GetSession(sessionID string) (*Session, error)
 	SessionCount() int
+	Drain()
+	AcceptingSessions() bool
 	GetTransactionFromContext(ctx context.Context) (transactions.Transaction, error)
+	GetSessionAndTransactionFromContext(ctx context.Context) (*Session, transactions.Transaction, error)
 	GetSessionFromContext(ctx context.Context) (*Session, error)
 	DeleteTransaction(transactions.Transaction) error
 	CommitTransaction(ctx context.Context, transaction transactions.Transaction) ([]*sql.SQLTx, error)
@@ -111,6 +142,22 @@ func NewManager(options *Options) (*manager, error) {
 
 	guard.options.Normalize()
 
+	if guard.options.TransactionObserver != nil {
+		guard.txEvents = make(chan *TransactionEvent, guard.options.TransactionEventsBufferSize)
+		guard.txEventsFlush = make(chan chan struct{})
+		guard.txEventsDone = make(chan struct{})
+		guard.txEventsStopped = make(chan struct{})
+
+		// events are delivered whether or not the sessions guard is running
+		go guard.dispatchTransactionEvents()
+	}
+
+	if guard.options.ExclusiveReadWriteTx {
+		guard.rwSlots = newReadWriteSlots()
+	}
+
+	guard.txOwners = newTransactionOwners()
+
 	return guard, nil
 }
 
@@ -123,6 +170,30 @@ func (sm *manager) shardFor(sessionID string) *sessionShard {
 }
 
 func (sm *manager) NewSession(user *auth.User, db database.DB) (*Session, error) {
+	return sm.newSession(user, db, "")
+}
+
+// DeriveReadOnlySession creates a new session bound to the same user and database
+// as the parent one, in which only read-only transactions can be opened.
+// The derived session has its own id and expires independently of the parent,
+// so it can be used for background reads without holding the parent session.
+func (sm *manager) DeriveReadOnlySession(parentID string) (*Session, error) {
+	parent, err := sm.GetSession(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.newSession(parent.GetUser(), parent.GetDatabase(), parentID)
+}
+
+// newSession creates and registers a new session, sessions derived from
+// a parent one are read-only
+func (sm *manager) newSession(user *auth.User, db database.DB, parentID string) (*Session, error) {
+	if sm.draining.Load() {
+		sm.logger.Warningf("session manager is draining")
+		return nil, ErrSessionsDraining
+	}
+
 	// Admission check via the atomic counter — stale by at most a few
 	// concurrent NewSession calls, which is acceptable for a soft cap.
 	if int(sm.sessionsCount.Load()) >= sm.options.MaxSessions {
@@ -130,19 +201,22 @@ func (sm *manager) NewSession(user *auth.User, db database.DB) (*Session, error)
 		return nil, ErrMaxSessionsReached
 	}
 
-	randomBytes := make([]byte, 32)
-	n, err := sm.options.RandSource.Read(randomBytes)
+	sessionID, err := sm.options.IDGenerator.NewSessionID()
 	if err != nil {
 		sm.logger.Errorf("cant create session id: %v", err)
 		return nil, ErrCantCreateSessionID
 	}
-	if n < len(randomBytes) {
-		sm.logger.Errorf("cant create session id: could produce enough random data")
-		return nil, ErrCantCreateSessionID
-	}
 
-	sessionID := base64.URLEncoding.EncodeToString(randomBytes)
-	sess := NewSession(sessionID, user, db, sm.logger)
+	sess := NewSession(sessionID, user, db, sm.options.IDGenerator, sm.logger)
+	if sm.txEvents != nil {
+		sess.onTransactionEnd = sm.notifyTransactionEvent
+	}
+	sess.rwSlots = sm.rwSlots
+	sess.txOwners = sm.txOwners
+	if parentID != "" {
+		sess.readOnly = true
+		sess.parentID = parentID
+	}
 
 	shard := sm.shardFor(sessionID)
 	shard.mu.Lock()
@@ -155,6 +229,36 @@ func (sm *manager) NewSession(user *auth.User, db database.DB) (*Session, error)
 	return sess, nil
 }
 
+// ResumeSession returns the session with the given id, so a client which lost its
+// connection can keep using it (including its ongoing transactions) after having
+// authenticated again. The session must still be alive and belong to the same user
+// and database. Its activity time is refreshed under the shard lock, so the session
+// can not be expired by the guard in the meantime.
+func (sm *manager) ResumeSession(sessionID string, user *auth.User, db database.DB) (*Session, error) {
+	if sessionID == "" {
+		return nil, ErrNoSessionIDPresent
+	}
+
+	shard := sm.shardFor(sessionID)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	sess, ok := shard.sessions[sessionID]
+	if !ok || sess.GetUser().Username != user.Username {
+		// sessions of other users are not disclosed
+		return nil, ErrSessionNotFound
+	}
+
+	if sess.GetDatabase().GetName() != db.GetName() {
+		return nil, ErrSessionDatabaseMismatch
+	}
+
+	sess.SetLastActivityTime(time.Now())
+	sm.logger.Debugf("resumed session %s", sessionID)
+
+	return sess, nil
+}
+
 func (sm *manager) SessionPresent(sessionID string) bool {
 	shard := sm.shardFor(sessionID)
 	shard.mu.RLock()
@@ -198,6 +302,8 @@ func (sm *manager) DeleteSession(sessionID string) error {
 // expensive enough to warrant doing out-of-lock. Returns the combined
 // result of CloseDocumentReaders and RollbackTransactions.
 func releaseSession(sess *Session) error {
+	sess.close()
+
 	merr := multierr.NewMultiErr()
 	if err := sess.CloseDocumentReaders(); err != nil {
 		merr.Append(err)
@@ -258,6 +364,19 @@ func (sm *manager) SessionCount() int {
 	return int(sm.sessionsCount.Load())
 }
 
+// Drain stops the admission of new sessions. Already opened sessions, as well as
+// the resumption of them, are not affected.
+func (sm *manager) Drain() {
+	sm.draining.Store(true)
+	sm.logger.Infof("draining sessions, new sessions are not accepted")
+}
+
+// AcceptingSessions returns false when new sessions would be refused, either
+// because the manager is draining or because the max number of sessions was reached.
+func (sm *manager) AcceptingSessions() bool {
+	return !sm.draining.Load() && sm.SessionCount() < sm.options.MaxSessions
+}
+
 func (sm *manager) StartSessionsGuard() error {
 	sm.runningMu.Lock()
 	defer sm.runningMu.Unlock()
@@ -331,10 +450,21 @@ func (sm *manager) StopSessionsGuard() error {
 		}
 	}
 
+	// events of the transactions rolled back on shutdown are delivered too
+	sm.flushTransactionEvents()
+
 	sm.logger.Debugf("shutdown")
 	return nil
 }
 
+// Close stops the delivery of transaction events once the pending ones have been
+// delivered to the observer. It's called after StopSessionsGuard, when the
+// manager is no longer used.
+func (sm *manager) Close() error {
+	sm.stopTransactionEvents()
+	return nil
+}
+
 // expireSessions iterates every shard and applies the same two-phase
 // (collect-under-lock, release-out-of-lock) discipline that the previous
 // single-map version used. Each shard is processed independently so
@@ -353,6 +483,8 @@ func (sm *manager) expireSessions(now time.Time) (sessionsCount, inactiveSessCou
 
 	sm.logger.Debugf("checking at %s", now.Format(time.UnixDate))
 
+	start := time.Now()
+
 	type expiredSession struct {
 		id     string
 		sess   *Session
@@ -360,6 +492,8 @@ func (sm *manager) expireSessions(now time.Time) (sessionsCount, inactiveSessCou
 	}
 
 	var expired []expiredSession
+	var inactive []*Session
+	var alive []*Session
 	var remaining int
 
 	for i := range sm.shards {
@@ -378,6 +512,12 @@ func (sm *manager) expireSessions(now time.Time) (sessionsCount, inactiveSessCou
 				delete(shard.sessions, ID)
 			case now.Sub(lastActivity) > sm.options.MaxSessionInactivityTime:
 				inactiveSessCount++
+				if sm.options.RollbackReadWriteTxOnInactivity {
+					inactive = append(inactive, sess)
+				}
+				alive = append(alive, sess)
+			default:
+				alive = append(alive, sess)
 			}
 		}
 		remaining += len(shard.sessions)
@@ -387,6 +527,7 @@ func (sm *manager) expireSessions(now time.Time) (sessionsCount, inactiveSessCou
 	for _, e := range expired {
 		sm.logger.Debugf("removing session %s - exceeded %s", e.id, e.reason)
 		sm.sessionsCount.Add(-1)
+		e.sess.close()
 		if err := e.sess.CloseDocumentReaders(); err != nil {
 			sm.logger.Errorf("closing document readers for %s: %v", e.id, err)
 		}
@@ -395,32 +536,85 @@ func (sm *manager) expireSessions(now time.Time) (sessionsCount, inactiveSessCou
 		}
 	}
 
+	for _, sess := range inactive {
+		if err := sess.RollbackReadWriteTransactions(); err != nil {
+			sm.logger.Errorf("rolling back read-write transactions for %s: %v", sess.GetID(), err)
+		}
+	}
+
+	if sm.options.TransactionTimeout != infinity || sm.options.ReadOnlyTransactionTimeout != infinity {
+		for _, sess := range alive {
+			err := sess.RollbackExpiredTransactions(now, sm.options.TransactionTimeout, sm.options.ReadOnlyTransactionTimeout)
+			if err != nil {
+				sm.logger.Errorf("rolling back expired transactions for %s: %v", sess.GetID(), err)
+			}
+		}
+	}
+
 	deletedSessCount = len(expired)
 
+	// stats are reported only after every expired session got its transactions rolled back
+	stats := &SweepStats{
+		Open:     remaining,
+		Inactive: inactiveSessCount,
+		Expired:  deletedSessCount,
+		Duration: time.Since(start),
+	}
+
+	if deletedSessCount > 0 {
+		sm.logger.Infof("%d sessions expired, %d open sessions (%d inactive), sweep took %s",
+			deletedSessCount, remaining, inactiveSessCount, stats.Duration)
+	}
+
 	sm.logger.Debugf("Open sessions count: %d\n", remaining)
 	sm.logger.Debugf("Inactive sessions count: %d\n", inactiveSessCount)
 	sm.logger.Debugf("Deleted sessions count: %d\n", deletedSessCount)
 
+	if sm.options.SweepObserver != nil {
+		sm.options.SweepObserver(stats)
+	}
+
 	return remaining, inactiveSessCount, deletedSessCount, nil
 }
 
 func (sm *manager) GetTransactionFromContext(ctx context.Context) (transactions.Transaction, error) {
-	sessionID, err := GetSessionIDFromContext(ctx)
+	_, tx, err := sm.GetSessionAndTransactionFromContext(ctx)
+	return tx, err
+}
+
+// GetSessionAndTransactionFromContext returns the session and the transaction provided in the context,
+// verifying the transaction is owned by the session. ErrTransactionNotOwned is returned when the
+// transaction belongs to a different session.
+func (sm *manager) GetSessionAndTransactionFromContext(ctx context.Context) (*Session, transactions.Transaction, error) {
+	sessionID, transactionID, err := GetSessionAndTransactionIDFromContext(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	sess, err := sm.GetSession(sessionID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	transactionID, err := GetTransactionIDFromContext(ctx)
+	tx, err := sess.GetTransaction(transactionID)
+	if errors.Is(err, ErrTransactionNotFound) && sm.transactionOwnedByOtherSession(sessionID, transactionID) {
+		return nil, nil, ErrTransactionNotOwned
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if tx.GetSessionID() != sessionID {
+		return nil, nil, ErrTransactionNotOwned
 	}
 
-	return sess.GetTransaction(transactionID)
+	return sess, tx, nil
+}
+
+// transactionOwnedByOtherSession returns true if the transaction is held by a session other than sessionID
+func (sm *manager) transactionOwnedByOtherSession(sessionID, transactionID string) bool {
+	ownerID, ok := sm.txOwners.owner(transactionID)
+	return ok && ownerID != sessionID
 }
 
 func (sm *manager) GetSessionFromContext(ctx context.Context) (*Session, error) {
@@ -441,15 +635,27 @@ func (sm *manager) DeleteTransaction(tx transactions.Transaction) error {
 	return sess.RemoveTransaction(tx.GetID())
 }
 
+// CommitTransaction commits the transaction and removes it from its session.
+// Once a read-write transaction is committed, the read-only transactions still
+// open in the same session observe its writes from their next query on.
 func (sm *manager) CommitTransaction(ctx context.Context, tx transactions.Transaction) ([]*sql.SQLTx, error) {
-	err := sm.DeleteTransaction(tx)
+	sess, err := sm.GetSession(tx.GetSessionID())
+	if err != nil {
+		return nil, err
+	}
+	err = sess.RemoveTransaction(tx.GetID())
 	if err != nil {
 		return nil, err
 	}
 	cTxs, err := tx.Commit(ctx)
 	if err != nil {
+		sm.notifyTransactionEvent(newTransactionEvent(tx, false, nil))
 		return nil, err
 	}
+	if hdr := lastTxHeader(cTxs); hdr != nil {
+		sess.requireSnapshotOf(hdr.ID)
+	}
+	sm.notifyTransactionEvent(newTransactionEvent(tx, true, cTxs))
 	return cTxs, nil
 }
 
@@ -458,5 +664,10 @@ func (sm *manager) RollbackTransaction(tx transactions.Transaction) error {
 	if err != nil {
 		return err
 	}
-	return tx.Rollback()
+	err = tx.Rollback()
+	if err != nil {
+		return err
+	}
+	sm.notifyTransactionEvent(newTransactionEvent(tx, false, nil))
+	return nil
 }
@@ -18,16 +18,24 @@ package sessions
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"math/bits"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/server/sessions/internal/transactions"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestNewManager(t *testing.T) {
@@ -99,6 +107,41 @@ func TestManagerMaxSessions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestManagerDrain(t *testing.T) {
+	m, err := NewManager(DefaultOptions().WithMaxSessions(2))
+	require.NoError(t, err)
+
+	user := &auth.User{Username: "user1"}
+
+	require.True(t, m.AcceptingSessions())
+
+	sess, err := m.NewSession(user, nil)
+	require.NoError(t, err)
+	require.True(t, m.AcceptingSessions())
+
+	sess2, err := m.NewSession(user, nil)
+	require.NoError(t, err)
+	require.False(t, m.AcceptingSessions())
+
+	err = m.DeleteSession(sess2.id)
+	require.NoError(t, err)
+	require.True(t, m.AcceptingSessions())
+
+	m.Drain()
+	require.False(t, m.AcceptingSessions())
+
+	_, err = m.NewSession(user, nil)
+	require.ErrorIs(t, err, ErrSessionsDraining)
+	require.ErrorIs(t, err, ErrCantCreateSession)
+
+	// existing sessions keep being served while draining
+	require.True(t, m.SessionPresent(sess.id))
+	require.Equal(t, 1, m.SessionCount())
+
+	err = m.DeleteSession(sess.id)
+	require.NoError(t, err)
+}
+
 func TestGetSessionNotFound(t *testing.T) {
 	m, err := NewManager(DefaultOptions())
 	require.NoError(t, err)
@@ -284,6 +327,689 @@ func TestManagerSessionExpiration(t *testing.T) {
 	})
 }
 
+func TestManagerHeartbeat(t *testing.T) {
+	m, err := NewManager(DefaultOptions().
+		WithMaxSessionInactivityTime(5 * time.Second).
+		WithTimeout(10 * time.Second),
+	)
+	require.NoError(t, err)
+
+	err = m.StartSessionsGuard()
+	require.NoError(t, err)
+	defer m.StopSessionsGuard()
+
+	_, err = m.Heartbeat("unknown")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	sess, err := m.NewSession(&auth.User{}, nil)
+	require.NoError(t, err)
+
+	sess.SetLastActivityTime(time.Now().Add(-time.Minute))
+
+	hb, err := m.Heartbeat(sess.id)
+	require.NoError(t, err)
+	require.Equal(t, SessionActive, hb.Status)
+	require.Equal(t, sess.GetLastActivityTime().Add(10*time.Second), hb.Deadline)
+	require.Equal(t, sess.GetLastActivityTime().Add(5*time.Second), hb.InactiveAt)
+
+	// the session is kept until the deadline and removed right after it
+	count, _, del, err := m.expireSessions(hb.Deadline)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Zero(t, del)
+
+	count, _, del, err = m.expireSessions(hb.Deadline.Add(time.Nanosecond))
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.Equal(t, 1, del)
+
+	t.Run("max session age bounds the deadline", func(t *testing.T) {
+		m.options.MaxSessionAgeTime = 30 * time.Second
+
+		sess, err := m.NewSession(&auth.User{}, nil)
+		require.NoError(t, err)
+
+		sess.creationTime = time.Now().Add(-25 * time.Second)
+
+		hb, err := m.Heartbeat(sess.id)
+		require.NoError(t, err)
+		require.Equal(t, sess.GetCreationTime().Add(30*time.Second), hb.Deadline)
+
+		count, _, del, err := m.expireSessions(hb.Deadline)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Zero(t, del)
+
+		count, _, del, err = m.expireSessions(hb.Deadline.Add(time.Nanosecond))
+		require.NoError(t, err)
+		require.Zero(t, count)
+		require.Equal(t, 1, del)
+	})
+
+	t.Run("sessions never expire without limits", func(t *testing.T) {
+		m.options.MaxSessionAgeTime = infinity
+		m.options.Timeout = infinity
+		m.options.MaxSessionInactivityTime = infinity
+
+		sess, err := m.NewSession(&auth.User{}, nil)
+		require.NoError(t, err)
+
+		m.Drain()
+
+		hb, err := m.Heartbeat(sess.id)
+		require.NoError(t, err)
+		require.Equal(t, SessionDraining, hb.Status)
+		require.True(t, hb.Deadline.IsZero())
+		require.True(t, hb.InactiveAt.IsZero())
+	})
+}
+
+func TestManagerRollbackReadWriteTxOnInactivity(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, rollback := range []bool{false, true} {
+		t.Run(fmt.Sprintf("rollback=%v", rollback), func(t *testing.T) {
+			m, err := NewManager(DefaultOptions().
+				WithMaxSessionInactivityTime(5 * time.Second).
+				WithTimeout(10 * time.Second).
+				WithRollbackReadWriteTxOnInactivity(rollback),
+			)
+			require.NoError(t, err)
+
+			err = m.StartSessionsGuard()
+			require.NoError(t, err)
+			defer m.StopSessionsGuard()
+
+			sess, err := m.NewSession(&auth.User{}, db)
+			require.NoError(t, err)
+
+			rwTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+			require.NoError(t, err)
+
+			roTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+			require.NoError(t, err)
+
+			nowTime := time.Now()
+			sess.lastActivityTime = nowTime.Add(-7 * time.Second)
+
+			count, inactive, del, err := m.expireSessions(nowTime)
+			require.NoError(t, err)
+			require.Equal(t, 1, count)
+			require.Equal(t, 1, inactive)
+			require.Zero(t, del)
+
+			_, err = sess.GetTransaction(roTx.GetID())
+			require.NoError(t, err)
+			require.False(t, roTx.IsClosed())
+
+			_, err = sess.GetTransaction(rwTx.GetID())
+			if rollback {
+				require.ErrorIs(t, err, ErrTransactionNotFound)
+				require.True(t, rwTx.IsClosed())
+			} else {
+				require.NoError(t, err)
+				require.False(t, rwTx.IsClosed())
+			}
+
+			_, err = m.GetSession(sess.GetID())
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManagerTransactionTimeout(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions().
+		WithMaxSessionInactivityTime(time.Hour).
+		WithTimeout(time.Hour).
+		WithTransactionTimeout(5 * time.Second).
+		WithReadOnlyTransactionTimeout(10 * time.Second),
+	)
+	require.NoError(t, err)
+
+	err = m.StartSessionsGuard()
+	require.NoError(t, err)
+	defer m.StopSessionsGuard()
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	rwTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	roTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	_, _, _, err = m.expireSessions(time.Now().Add(7 * time.Second))
+	require.NoError(t, err)
+
+	_, err = sess.GetTransaction(rwTx.GetID())
+	require.ErrorIs(t, err, ErrTransactionExpired)
+	require.True(t, rwTx.IsClosed())
+
+	_, err = sess.GetTransaction(roTx.GetID())
+	require.NoError(t, err)
+	require.False(t, roTx.IsClosed())
+
+	_, _, _, err = m.expireSessions(time.Now().Add(12 * time.Second))
+	require.NoError(t, err)
+
+	_, err = sess.GetTransaction(roTx.GetID())
+	require.ErrorIs(t, err, ErrTransactionExpired)
+	require.True(t, roTx.IsClosed())
+
+	_, err = sess.GetTransaction("unknown")
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+
+	// the session is kept
+	_, err = m.GetSession(sess.GetID())
+	require.NoError(t, err)
+}
+
+func TestManagerExclusiveReadWriteTx(t *testing.T) {
+	db1, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db1.Close()
+
+	db2, err := database.NewDB("db2", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db2.Close()
+
+	t.Run("concurrent read-write transactions are allowed by default", func(t *testing.T) {
+		m, err := NewManager(DefaultOptions())
+		require.NoError(t, err)
+
+		sess1, err := m.NewSession(&auth.User{}, db1)
+		require.NoError(t, err)
+
+		sess2, err := m.NewSession(&auth.User{}, db1)
+		require.NoError(t, err)
+
+		tx1, err := sess1.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+		defer tx1.Rollback()
+
+		tx2, err := sess2.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+		defer tx2.Rollback()
+	})
+
+	m, err := NewManager(DefaultOptions().WithExclusiveReadWriteTx(true))
+	require.NoError(t, err)
+
+	sess1, err := m.NewSession(&auth.User{}, db1)
+	require.NoError(t, err)
+
+	sess2, err := m.NewSession(&auth.User{}, db1)
+	require.NoError(t, err)
+
+	sess3, err := m.NewSession(&auth.User{}, db2)
+	require.NoError(t, err)
+
+	tx, err := sess1.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	_, err = sess2.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.ErrorIs(t, err, ErrOngoingReadWriteTx)
+
+	var ongoingErr *OngoingReadWriteTxError
+	require.ErrorAs(t, err, &ongoingErr)
+	require.Equal(t, tx.GetID(), ongoingErr.TransactionID)
+	require.Equal(t, "db1", ongoingErr.Database)
+	require.Equal(t, tx.CreatedAt(), ongoingErr.CreatedAt)
+	require.NotContains(t, err.Error(), sess1.GetID())
+
+	// the slot is per database, not per session
+	_, err = sess1.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.ErrorIs(t, err, ErrOngoingReadWriteTx)
+
+	roTx, err := sess2.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	otherTx, err := sess3.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = m.RollbackTransaction(tx)
+	require.NoError(t, err)
+
+	tx, err = sess2.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	_, err = m.CommitTransaction(context.Background(), tx)
+	require.NoError(t, err)
+
+	tx, err = sess1.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	// closing the session releases the slot
+	err = m.DeleteSession(sess1.GetID())
+	require.NoError(t, err)
+
+	tx, err = sess2.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	require.NoError(t, m.RollbackTransaction(tx))
+	require.NoError(t, m.RollbackTransaction(roTx))
+	require.NoError(t, m.RollbackTransaction(otherTx))
+}
+
+func TestManagerReadOnlyTxObservesSessionCommits(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "CREATE TABLE t1(id INTEGER, PRIMARY KEY id)"})
+	require.NoError(t, err)
+
+	m, err := NewManager(DefaultOptions())
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	otherSess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	countRows := func(tx transactions.Transaction) int {
+		reader, err := tx.SQLQuery(context.Background(), &schema.SQLQueryRequest{Sql: "SELECT id FROM t1"})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		n := 0
+		for {
+			_, err := reader.Read(context.Background())
+			if errors.Is(err, sql.ErrNoMoreRows) {
+				return n
+			}
+			require.NoError(t, err)
+			n++
+		}
+	}
+
+	roTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	otherRoTx, err := otherSess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	require.Zero(t, countRows(roTx))
+	require.Zero(t, countRows(otherRoTx))
+
+	rwTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	_, err = rwTx.SQLExec(context.Background(), &schema.SQLExecRequest{Sql: "INSERT INTO t1(id) VALUES (1)"})
+	require.NoError(t, err)
+
+	// uncommitted writes are not visible
+	require.Zero(t, countRows(roTx))
+
+	cTxs, err := m.CommitTransaction(context.Background(), rwTx)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, countRows(roTx))
+
+	snapshotTxID, err := roTx.SnapshotTxID()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, snapshotTxID, cTxs[0].TxHeader().ID)
+
+	// read-only transactions of other sessions keep their snapshot
+	require.Zero(t, countRows(otherRoTx))
+
+	require.NoError(t, m.RollbackTransaction(roTx))
+	require.NoError(t, m.RollbackTransaction(otherRoTx))
+}
+
+func TestManagerTransactionObserver(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	events := make(chan *TransactionEvent, 10)
+
+	m, err := NewManager(DefaultOptions().
+		WithMaxSessionInactivityTime(time.Hour).
+		WithTimeout(time.Hour).
+		WithTransactionTimeout(5 * time.Second).
+		WithTransactionObserver(func(event *TransactionEvent) {
+			events <- event
+		}),
+	)
+	require.NoError(t, err)
+	defer m.Close()
+
+	err = m.StartSessionsGuard()
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	t.Run("commit", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		_, err = tx.SQLExec(context.Background(), &schema.SQLExecRequest{Sql: "CREATE TABLE t1(id INTEGER, PRIMARY KEY id)"})
+		require.NoError(t, err)
+
+		cTxs, err := m.CommitTransaction(context.Background(), tx)
+		require.NoError(t, err)
+		require.Len(t, cTxs, 1)
+
+		event := <-events
+		require.Equal(t, tx.GetID(), event.TransactionID)
+		require.Equal(t, sess.GetID(), event.SessionID)
+		require.Equal(t, "db1", event.Database)
+		require.False(t, event.ReadOnly)
+		require.True(t, event.Committed)
+		require.NotNil(t, event.TxHeader)
+		require.Equal(t, cTxs[0].TxHeader().ID, event.TxHeader.Id)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		err = m.RollbackTransaction(tx)
+		require.NoError(t, err)
+
+		event := <-events
+		require.Equal(t, tx.GetID(), event.TransactionID)
+		require.True(t, event.ReadOnly)
+		require.False(t, event.Committed)
+		require.Nil(t, event.TxHeader)
+	})
+
+	t.Run("failed commit", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		// the SQL transaction is closed behind the session, so the commit fails
+		require.NoError(t, tx.Rollback())
+
+		_, err = m.CommitTransaction(context.Background(), tx)
+		require.ErrorIs(t, err, sql.ErrNoOngoingTx)
+
+		event := <-events
+		require.Equal(t, tx.GetID(), event.TransactionID)
+		require.False(t, event.Committed)
+		require.Nil(t, event.TxHeader)
+	})
+
+	t.Run("rollback on timeout", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		_, _, _, err = m.expireSessions(time.Now().Add(7 * time.Second))
+		require.NoError(t, err)
+
+		event := <-events
+		require.Equal(t, tx.GetID(), event.TransactionID)
+		require.False(t, event.Committed)
+	})
+
+	t.Run("rollback on shutdown", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = m.StopSessionsGuard()
+		require.NoError(t, err)
+
+		// pending events are delivered before the guard is stopped
+		require.Len(t, events, 1)
+
+		event := <-events
+		require.Equal(t, tx.GetID(), event.TransactionID)
+		require.False(t, event.Committed)
+	})
+}
+
+func TestManagerTransactionObserverWithoutGuard(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	events := make(chan *TransactionEvent, 10)
+
+	m, err := NewManager(DefaultOptions().
+		WithTransactionObserver(func(event *TransactionEvent) {
+			events <- event
+		}),
+	)
+	require.NoError(t, err)
+	defer m.Close()
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	err = m.RollbackTransaction(tx)
+	require.NoError(t, err)
+
+	select {
+	case event := <-events:
+		require.Equal(t, tx.GetID(), event.TransactionID)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "event not delivered while the sessions guard is not running")
+	}
+}
+
+func TestManagerSlowTransactionObserver(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	release := make(chan struct{})
+	var notified atomic.Int32
+
+	m, err := NewManager(DefaultOptions().
+		WithTransactionEventsBufferSize(1).
+		WithTransactionObserver(func(event *TransactionEvent) {
+			<-release
+			notified.Add(1)
+		}),
+	)
+	require.NoError(t, err)
+	defer m.Close()
+
+	err = m.StartSessionsGuard()
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	// neither a blocked observer nor a full buffer stall the transactions
+	for i := 0; i < 5; i++ {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		err = m.RollbackTransaction(tx)
+		require.NoError(t, err)
+	}
+
+	close(release)
+
+	err = m.StopSessionsGuard()
+	require.NoError(t, err)
+
+	// the first event is being delivered while the second one fills the buffer
+	require.LessOrEqual(t, notified.Load(), int32(2))
+	require.Greater(t, notified.Load(), int32(0))
+}
+
+func TestManagerCloseTransactionObserver(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	release := make(chan struct{})
+	var notified atomic.Int32
+
+	m, err := NewManager(DefaultOptions().
+		WithTransactionObserver(func(event *TransactionEvent) {
+			<-release
+			notified.Add(1)
+		}),
+	)
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		err = m.RollbackTransaction(tx)
+		require.NoError(t, err)
+	}
+
+	close(release)
+
+	// pending events are delivered before the dispatcher returns
+	err = m.Close()
+	require.NoError(t, err)
+	require.Equal(t, int32(3), notified.Load())
+
+	select {
+	case <-m.txEventsStopped:
+	default:
+		require.Fail(t, "dispatcher still running after the manager was closed")
+	}
+
+	// closing again and flushing after close do not block
+	err = m.Close()
+	require.NoError(t, err)
+
+	m.flushTransactionEvents()
+}
+
+func TestTransactionEventsBufferSizeValidation(t *testing.T) {
+	_, err := NewManager(DefaultOptions().WithTransactionEventsBufferSize(-1))
+	require.ErrorIs(t, err, ErrInvalidOptionsProvided)
+}
+
+func TestManagerResumeSession(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	otherDB, err := database.NewDB("db2", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer otherDB.Close()
+
+	m, err := NewManager(DefaultOptions().
+		WithMaxSessionInactivityTime(time.Hour).
+		WithTimeout(time.Hour))
+	require.NoError(t, err)
+
+	err = m.StartSessionsGuard()
+	require.NoError(t, err)
+	defer m.StopSessionsGuard()
+
+	user := &auth.User{Username: "user1"}
+
+	sess, err := m.NewSession(user, db)
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	lastActivityTime := sess.GetLastActivityTime()
+
+	_, err = m.ResumeSession("", user, db)
+	require.ErrorIs(t, err, ErrNoSessionIDPresent)
+
+	_, err = m.ResumeSession("unknown", user, db)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	_, err = m.ResumeSession(sess.GetID(), &auth.User{Username: "user2"}, db)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	_, err = m.ResumeSession(sess.GetID(), user, otherDB)
+	require.ErrorIs(t, err, ErrSessionDatabaseMismatch)
+
+	time.Sleep(time.Millisecond)
+
+	resumed, err := m.ResumeSession(sess.GetID(), user, db)
+	require.NoError(t, err)
+	require.Same(t, sess, resumed)
+	require.True(t, resumed.GetLastActivityTime().After(lastActivityTime))
+	require.Equal(t, 1, m.SessionCount())
+
+	resumedTx, err := resumed.GetTransaction(tx.GetID())
+	require.NoError(t, err)
+	require.Same(t, tx, resumedTx)
+
+	// expired sessions can not be resumed
+	_, _, _, err = m.expireSessions(time.Now().Add(2 * time.Hour))
+	require.NoError(t, err)
+
+	_, err = m.ResumeSession(sess.GetID(), user, db)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	require.True(t, tx.IsClosed())
+}
+
+func TestManagerDeriveReadOnlySession(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions().WithMaxSessions(2))
+	require.NoError(t, err)
+
+	user := &auth.User{Username: "user1"}
+
+	parent, err := m.NewSession(user, db)
+	require.NoError(t, err)
+	require.False(t, parent.IsReadOnly())
+	require.Empty(t, parent.GetParentID())
+
+	_, err = m.DeriveReadOnlySession("unknown")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	parentTx, err := parent.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	derived, err := m.DeriveReadOnlySession(parent.GetID())
+	require.NoError(t, err)
+	require.NotEqual(t, parent.GetID(), derived.GetID())
+	require.True(t, derived.IsReadOnly())
+	require.Equal(t, parent.GetID(), derived.GetParentID())
+	require.Same(t, user, derived.GetUser())
+	require.Same(t, db, derived.GetDatabase())
+	require.Equal(t, 2, m.SessionCount())
+
+	_, err = m.DeriveReadOnlySession(parent.GetID())
+	require.ErrorIs(t, err, ErrMaxSessionsReached)
+
+	_, err = derived.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.ErrorIs(t, err, ErrReadWriteTXNotAllowed)
+
+	_, err = derived.NewTransaction(context.Background(), nil)
+	require.ErrorIs(t, err, ErrReadWriteTXNotAllowed)
+
+	tx, err := derived.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	require.True(t, tx.IsReadOnly())
+
+	// the derived session has its own lifecycle
+	err = m.DeleteSession(derived.GetID())
+	require.NoError(t, err)
+	require.True(t, tx.IsClosed())
+	require.False(t, parentTx.IsClosed())
+	require.True(t, m.SessionPresent(parent.GetID()))
+
+	err = m.DeleteSession(parent.GetID())
+	require.NoError(t, err)
+}
+
 func TestManagerNewSessionCryptographicQuality(t *testing.T) {
 	m, err := NewManager(DefaultOptions())
 	require.NoError(t, err)
@@ -328,6 +1054,46 @@ func TestManagerNewSessionFailureForNoRandomSource(t *testing.T) {
 		_, err = m.NewSession(&auth.User{}, nil)
 		require.ErrorIs(t, err, ErrCantCreateSession)
 	})
+
+	t.Run("short reads from the random source are not completed", func(t *testing.T) {
+		_, err := NewDefaultIDGenerator(bytes.NewReader([]byte{0x00})).NewSessionID()
+		require.ErrorIs(t, err, ErrNotEnoughRandomData)
+	})
+}
+
+type sequentialIDGenerator struct {
+	n atomic.Int64
+}
+
+func (g *sequentialIDGenerator) NewSessionID() (string, error) {
+	return fmt.Sprintf("session-%d", g.n.Add(1)), nil
+}
+
+func (g *sequentialIDGenerator) NewTransactionID() (string, error) {
+	return fmt.Sprintf("tx-%d", g.n.Add(1)), nil
+}
+
+func TestManagerCustomIDGenerator(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions().WithIDGenerator(&sequentialIDGenerator{}))
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+	require.Equal(t, "session-1", sess.GetID())
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+	require.Equal(t, "tx-2", tx.GetID())
+
+	err = m.RollbackTransaction(tx)
+	require.NoError(t, err)
+
+	err = m.DeleteSession(sess.GetID())
+	require.NoError(t, err)
 }
 
 func TestCloseSessionsForUser(t *testing.T) {
@@ -362,3 +1128,125 @@ func TestCloseSessionsForUser(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, m.SessionCount())
 }
+
+func TestManagerSweepObserver(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	var stats []*SweepStats
+	var rwTx transactions.Transaction
+
+	m, err := NewManager(DefaultOptions().
+		WithMaxSessionInactivityTime(5 * time.Second).
+		WithTimeout(10 * time.Second).
+		WithSweepObserver(func(s *SweepStats) {
+			// transactions of the expired sessions are rolled back before the sweep is reported
+			if s.Expired > 0 {
+				require.True(t, rwTx.IsClosed())
+			}
+			stats = append(stats, s)
+		}),
+	)
+	require.NoError(t, err)
+
+	err = m.StartSessionsGuard()
+	require.NoError(t, err)
+	defer m.StopSessionsGuard()
+
+	sess1, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	rwTx, err = sess1.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	_, err = m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	_, _, _, err = m.expireSessions(time.Now())
+	require.NoError(t, err)
+
+	_, _, _, err = m.expireSessions(time.Now().Add(7 * time.Second))
+	require.NoError(t, err)
+	require.False(t, rwTx.IsClosed())
+
+	sess1.SetLastActivityTime(time.Now().Add(-time.Minute))
+
+	_, _, _, err = m.expireSessions(time.Now())
+	require.NoError(t, err)
+
+	require.Len(t, stats, 3)
+
+	require.Equal(t, 2, stats[0].Open)
+	require.Zero(t, stats[0].Inactive)
+	require.Zero(t, stats[0].Expired)
+
+	require.Equal(t, 2, stats[1].Open)
+	require.Equal(t, 2, stats[1].Inactive)
+	require.Zero(t, stats[1].Expired)
+
+	require.Equal(t, 1, stats[2].Open)
+	require.Zero(t, stats[2].Inactive)
+	require.Equal(t, 1, stats[2].Expired)
+	require.True(t, rwTx.IsClosed())
+
+	for _, s := range stats {
+		require.Positive(t, s.Duration)
+	}
+}
+
+func TestManagerGetSessionAndTransactionFromContext(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", os.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions())
+	require.NoError(t, err)
+
+	sess1, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	sess2, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	tx1, err := sess1.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	ctxFor := func(sessionID, transactionID string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", sessionID, "transactionid", transactionID))
+	}
+
+	_, _, err = m.GetSessionAndTransactionFromContext(context.Background())
+	require.ErrorIs(t, err, ErrNoSessionAuthDataProvided)
+
+	_, _, err = m.GetSessionAndTransactionFromContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", sess1.GetID())))
+	require.ErrorIs(t, err, ErrNoTransactionAuthDataProvided)
+
+	_, _, err = m.GetSessionAndTransactionFromContext(ctxFor("unknown", tx1.GetID()))
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	sess, tx, err := m.GetSessionAndTransactionFromContext(ctxFor(sess1.GetID(), tx1.GetID()))
+	require.NoError(t, err)
+	require.Equal(t, sess1, sess)
+	require.Equal(t, tx1, tx)
+
+	_, _, err = m.GetSessionAndTransactionFromContext(ctxFor(sess1.GetID(), "unknown"))
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+
+	// the transaction can not be used from a different session
+	_, _, err = m.GetSessionAndTransactionFromContext(ctxFor(sess2.GetID(), tx1.GetID()))
+	require.ErrorIs(t, err, ErrTransactionNotOwned)
+
+	_, err = m.GetTransactionFromContext(ctxFor(sess2.GetID(), tx1.GetID()))
+	require.ErrorIs(t, err, ErrTransactionNotOwned)
+
+	// once the transaction is over, it's not found by any session
+	err = m.RollbackTransaction(tx1)
+	require.NoError(t, err)
+
+	_, _, err = m.GetSessionAndTransactionFromContext(ctxFor(sess2.GetID(), tx1.GetID()))
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+
+	_, _, err = m.GetSessionAndTransactionFromContext(ctxFor(sess1.GetID(), tx1.GetID()))
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+}
@@ -35,6 +35,28 @@ type Options struct {
 	MaxSessions int
 	// Random number generator
 	RandSource io.Reader
+	// Generator of session and transaction IDs, if not set session IDs are
+	// built from RandSource and transaction IDs are xids
+	IDGenerator IDGenerator
+	// RollbackReadWriteTxOnInactivity rolls back the read-write transactions of a session once
+	// it is declared inactive, read-only transactions are kept and the session can still be used
+	RollbackReadWriteTxOnInactivity bool
+	// TransactionTimeout is the maximum amount of time a read-write transaction may stay open,
+	// once exceeded the transaction is rolled back by the server
+	TransactionTimeout time.Duration
+	// ReadOnlyTransactionTimeout is the maximum amount of time a read-only transaction may stay open,
+	// once exceeded the transaction is rolled back by the server
+	ReadOnlyTransactionTimeout time.Duration
+	// TransactionObserver, if set, is notified every time a session transaction is committed or rolled back
+	TransactionObserver TransactionObserver
+	// TransactionEventsBufferSize is the number of events queued while waiting to be delivered
+	// to the TransactionObserver, events are dropped once the buffer is full
+	TransactionEventsBufferSize int
+	// SweepObserver, if set, is notified with the outcome of every run of the sessions guard
+	SweepObserver SweepObserver
+	// ExclusiveReadWriteTx allows a single read-write transaction per database across all the sessions,
+	// further read-write transactions on the same database are rejected until it's committed or rolled back
+	ExclusiveReadWriteTx bool
 }
 
 func DefaultOptions() *Options {
@@ -77,6 +99,46 @@ func (o *Options) WithRandSource(src io.Reader) *Options {
 	return o
 }
 
+func (o *Options) WithIDGenerator(idGenerator IDGenerator) *Options {
+	o.IDGenerator = idGenerator
+	return o
+}
+
+func (o *Options) WithRollbackReadWriteTxOnInactivity(rollback bool) *Options {
+	o.RollbackReadWriteTxOnInactivity = rollback
+	return o
+}
+
+func (o *Options) WithTransactionTimeout(timeout time.Duration) *Options {
+	o.TransactionTimeout = timeout
+	return o
+}
+
+func (o *Options) WithReadOnlyTransactionTimeout(timeout time.Duration) *Options {
+	o.ReadOnlyTransactionTimeout = timeout
+	return o
+}
+
+func (o *Options) WithTransactionObserver(observer TransactionObserver) *Options {
+	o.TransactionObserver = observer
+	return o
+}
+
+func (o *Options) WithTransactionEventsBufferSize(size int) *Options {
+	o.TransactionEventsBufferSize = size
+	return o
+}
+
+func (o *Options) WithSweepObserver(observer SweepObserver) *Options {
+	o.SweepObserver = observer
+	return o
+}
+
+func (o *Options) WithExclusiveReadWriteTx(exclusive bool) *Options {
+	o.ExclusiveReadWriteTx = exclusive
+	return o
+}
+
 func (o *Options) Validate() error {
 	if o.MaxSessionAgeTime < 0 {
 		return fmt.Errorf("%w: invalid MaxSessionAgeTime", ErrInvalidOptionsProvided)
@@ -87,13 +149,22 @@ func (o *Options) Validate() error {
 	if o.Timeout < 0 {
 		return fmt.Errorf("%w: invalid Timeout", ErrInvalidOptionsProvided)
 	}
+	if o.TransactionTimeout < 0 {
+		return fmt.Errorf("%w: invalid TransactionTimeout", ErrInvalidOptionsProvided)
+	}
+	if o.ReadOnlyTransactionTimeout < 0 {
+		return fmt.Errorf("%w: invalid ReadOnlyTransactionTimeout", ErrInvalidOptionsProvided)
+	}
+	if o.TransactionEventsBufferSize < 0 {
+		return fmt.Errorf("%w: invalid TransactionEventsBufferSize", ErrInvalidOptionsProvided)
+	}
 	if o.SessionGuardCheckInterval <= 0 {
 		return fmt.Errorf("%w: invalid SessionGuardCheckInterval", ErrInvalidOptionsProvided)
 	}
 	if o.MaxSessions <= 0 {
 		return fmt.Errorf("%w: invalid MaxSessions", ErrInvalidOptionsProvided)
 	}
-	if o.RandSource == nil {
+	if o.RandSource == nil && o.IDGenerator == nil {
 		return fmt.Errorf("%w: invalid RandSource", ErrInvalidOptionsProvided)
 	}
 	return nil
@@ -109,5 +180,17 @@ func (o *Options) Normalize() *Options {
 	if o.Timeout == 0 {
 		o.Timeout = infinity
 	}
+	if o.TransactionTimeout == 0 {
+		o.TransactionTimeout = infinity
+	}
+	if o.ReadOnlyTransactionTimeout == 0 {
+		o.ReadOnlyTransactionTimeout = infinity
+	}
+	if o.IDGenerator == nil {
+		o.IDGenerator = NewDefaultIDGenerator(o.RandSource)
+	}
+	if o.TransactionEventsBufferSize == 0 {
+		o.TransactionEventsBufferSize = DefaultTransactionEventsBufferSize
+	}
 	return o
 }
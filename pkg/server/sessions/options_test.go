@@ -30,13 +30,18 @@ func TestOptions(t *testing.T) {
 	op := Options{}
 
 	randSrc := bytes.NewReader([]byte{})
+	idGen := NewDefaultIDGenerator(randSrc)
 
 	op.WithMaxSessionAgeTime(time.Second).
 		WithSessionGuardCheckInterval(2 * time.Second).
 		WithMaxSessionInactivityTime(3 * time.Second).
 		WithTimeout(4 * time.Second).
 		WithMaxSessions(99).
-		WithRandSource(randSrc)
+		WithRandSource(randSrc).
+		WithIDGenerator(idGen).
+		WithRollbackReadWriteTxOnInactivity(true).
+		WithTransactionTimeout(5 * time.Second).
+		WithReadOnlyTransactionTimeout(6 * time.Second)
 
 	assert.Equal(t, time.Second, op.MaxSessionAgeTime)
 	assert.Equal(t, 2*time.Second, op.SessionGuardCheckInterval)
@@ -44,6 +49,10 @@ func TestOptions(t *testing.T) {
 	assert.Equal(t, 4*time.Second, op.Timeout)
 	assert.Equal(t, 99, op.MaxSessions)
 	assert.Equal(t, randSrc, op.RandSource)
+	assert.Equal(t, idGen, op.IDGenerator)
+	assert.True(t, op.RollbackReadWriteTxOnInactivity)
+	assert.Equal(t, 5*time.Second, op.TransactionTimeout)
+	assert.Equal(t, 6*time.Second, op.ReadOnlyTransactionTimeout)
 }
 
 func TestOptionsValidate(t *testing.T) {
@@ -51,12 +60,17 @@ func TestOptionsValidate(t *testing.T) {
 	err := op.Validate()
 	require.NoError(t, err)
 
+	err = DefaultOptions().WithRandSource(nil).WithIDGenerator(NewDefaultIDGenerator(nil)).Validate()
+	require.NoError(t, err)
+
 	for _, op := range []*Options{
 		DefaultOptions().WithSessionGuardCheckInterval(0),
 		DefaultOptions().WithSessionGuardCheckInterval(-1 * time.Second),
 		DefaultOptions().WithMaxSessionInactivityTime(-1 * time.Second),
 		DefaultOptions().WithMaxSessionAgeTime(-1 * time.Second),
 		DefaultOptions().WithTimeout(-1 * time.Second),
+		DefaultOptions().WithTransactionTimeout(-1 * time.Second),
+		DefaultOptions().WithReadOnlyTransactionTimeout(-1 * time.Second),
 		DefaultOptions().WithMaxSessions(0),
 		DefaultOptions().WithMaxSessions(-1),
 		DefaultOptions().WithRandSource(nil),
@@ -78,4 +92,7 @@ func TestOptionsNormalize(t *testing.T) {
 	require.Equal(t, infinity, opts.MaxSessionInactivityTime)
 	require.Equal(t, infinity, opts.MaxSessionAgeTime)
 	require.Equal(t, infinity, opts.Timeout)
+	require.Equal(t, infinity, opts.TransactionTimeout)
+	require.Equal(t, infinity, opts.ReadOnlyTransactionTimeout)
+	require.NotNil(t, opts.IDGenerator)
 }
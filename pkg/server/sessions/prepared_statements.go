@@ -0,0 +1,202 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/cache"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/server/sessions/internal/transactions"
+)
+
+// DefaultMaxPreparedStatements is the default maximum number of prepared statements kept by a session,
+// the least recently used ones are evicted once exceeded
+const DefaultMaxPreparedStatements = 64
+
+// PreparedStatement holds SQL parsed once to be run many times within a session
+type PreparedStatement struct {
+	handle string
+	sql    string
+	stmts  []sql.SQLStmt
+	params map[string]sql.SQLValueType
+}
+
+// Handle identifies the prepared statement within the session, the same SQL is always assigned the same handle
+func (ps *PreparedStatement) Handle() string {
+	return ps.handle
+}
+
+func (ps *PreparedStatement) SQL() string {
+	return ps.sql
+}
+
+// Params returns the parameters of the statement along with their types
+func (ps *PreparedStatement) Params() map[string]sql.SQLValueType {
+	return ps.params
+}
+
+func preparedStatementHandle(sqlText string) string {
+	digest := sha256.Sum256([]byte(sqlText))
+	return hex.EncodeToString(digest[:])
+}
+
+// PrepareStatement parses the SQL and keeps it in the session, so it can be run through its handle
+// without being parsed again. Preparing the same SQL again returns the statement already prepared.
+func (s *Session) PrepareStatement(ctx context.Context, sqlText string) (*PreparedStatement, error) {
+	handle := preparedStatementHandle(sqlText)
+
+	// statements are prepared against the database set when the preparation started,
+	// the cache is replaced if the database is changed in the meantime
+	s.mux.RLock()
+	db := s.database
+	preparedStmts := s.preparedStmts
+	s.mux.RUnlock()
+
+	if ps, err := preparedStmts.Get(handle); err == nil {
+		return ps.(*PreparedStatement), nil
+	}
+
+	stmts, err := sql.ParseSQL(strings.NewReader(sqlText))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) == 0 {
+		return nil, sql.ErrIllegalArguments
+	}
+
+	params := make(map[string]sql.SQLValueType)
+
+	for _, stmt := range stmts {
+		stmtParams, err := db.InferParametersPrepared(ctx, nil, stmt)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, typ := range stmtParams {
+			params[name] = typ
+		}
+	}
+
+	ps := &PreparedStatement{
+		handle: handle,
+		sql:    sqlText,
+		stmts:  stmts,
+		params: params,
+	}
+
+	_, _, err = preparedStmts.Put(handle, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (s *Session) getPreparedStatement(handle string) (*PreparedStatement, error) {
+	s.mux.RLock()
+	preparedStmts := s.preparedStmts
+	s.mux.RUnlock()
+
+	ps, err := preparedStmts.Get(handle)
+	if err != nil {
+		return nil, ErrPreparedStatementNotFound
+	}
+
+	return ps.(*PreparedStatement), nil
+}
+
+// ExecPrepared runs a prepared statement within the transaction provided in the context,
+// statements are committed right away if no transaction is provided
+func (s *Session) ExecPrepared(ctx context.Context, handle string, params map[string]interface{}) (*transactions.ExecResult, error) {
+	ps, err := s.getPreparedStatement(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.transactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx != nil {
+		return tx.SQLExecPrepared(ctx, ps.stmts, params)
+	}
+
+	if s.IsReadOnly() {
+		return nil, ErrReadWriteTXNotAllowed
+	}
+
+	_, committedTxs, err := s.GetDatabase().SQLExecPrepared(ctx, nil, ps.stmts, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions.NewExecResult(committedTxs), nil
+}
+
+// QueryPrepared runs a prepared query within the transaction provided in the context,
+// the latest committed state is read if no transaction is provided
+func (s *Session) QueryPrepared(ctx context.Context, handle string, params map[string]interface{}) (sql.RowReader, error) {
+	ps, err := s.getPreparedStatement(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ps.stmts) != 1 {
+		return nil, sql.ErrExpectingDQLStmt
+	}
+
+	stmt, ok := ps.stmts[0].(sql.DataSource)
+	if !ok {
+		return nil, sql.ErrExpectingDQLStmt
+	}
+
+	tx, err := s.transactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx != nil {
+		return tx.SQLQueryPrepared(ctx, stmt, params)
+	}
+
+	return s.GetDatabase().SQLQueryPrepared(ctx, nil, stmt, params)
+}
+
+// transactionFromContext returns the transaction of the session provided in the context, nil if none is provided
+func (s *Session) transactionFromContext(ctx context.Context) (transactions.Transaction, error) {
+	transactionID, err := GetTransactionIDFromContext(ctx)
+	if errors.Is(err, ErrNoTransactionAuthDataProvided) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetTransaction(transactionID)
+}
+
+func newPreparedStatementsCache() *cache.Cache {
+	preparedStmts, _ := cache.NewCache(DefaultMaxPreparedStatements)
+	return preparedStmts
+}
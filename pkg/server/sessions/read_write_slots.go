@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/server/sessions/internal/transactions"
+)
+
+// readWriteSlots tracks the read-write transaction open on each database across all the sessions,
+// it's only used when ExclusiveReadWriteTx is enabled
+type readWriteSlots struct {
+	mu    sync.Mutex
+	slots map[string]*readWriteSlot
+}
+
+// readWriteSlot is held by a read-write transaction from the moment its id is assigned,
+// tx is set once the transaction has been opened
+type readWriteSlot struct {
+	transactionID string
+	createdAt     time.Time
+	tx            transactions.Transaction
+}
+
+func newReadWriteSlots() *readWriteSlots {
+	return &readWriteSlots{slots: make(map[string]*readWriteSlot)}
+}
+
+// reserve assigns the slot of the database to the transaction. The slot is considered free once
+// the transaction holding it is closed, so it's released by commits and rollbacks of any kind.
+func (s *readWriteSlots) reserve(db, transactionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slot, ok := s.slots[db]
+	if ok && (slot.tx == nil || !slot.tx.IsClosed()) {
+		return &OngoingReadWriteTxError{
+			TransactionID: slot.transactionID,
+			CreatedAt:     slot.createdAt,
+			Database:      db,
+		}
+	}
+
+	s.slots[db] = &readWriteSlot{
+		transactionID: transactionID,
+		createdAt:     time.Now(),
+	}
+
+	return nil
+}
+
+// assign binds the opened transaction to the slot it reserved
+func (s *readWriteSlots) assign(db string, tx transactions.Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if slot, ok := s.slots[db]; ok && slot.transactionID == tx.GetID() {
+		slot.tx = tx
+		slot.createdAt = tx.CreatedAt()
+	}
+}
+
+// release frees the slot reserved by a transaction which could not be opened
+func (s *readWriteSlots) release(db, transactionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if slot, ok := s.slots[db]; ok && slot.transactionID == transactionID {
+		delete(s.slots, db)
+	}
+}
@@ -38,6 +38,13 @@ import (
 // DefaultMaxDocumentReadersCacheSize is the default maximum number of document readers to keep in cache
 const DefaultMaxDocumentReadersCacheSize = 1
 
+const (
+	// rollbackAttempts bounds the number of times a failing rollback is attempted
+	// before the transaction is discarded anyway
+	rollbackAttempts   = 3
+	rollbackRetryDelay = 10 * time.Millisecond
+)
+
 var (
 	ErrPaginatedDocumentReaderNotFound = errors.New("document reader not found")
 )
@@ -47,6 +54,7 @@ type PaginatedDocumentReader struct {
 	Query          *protomodel.Query
 	LastPageNumber uint32 // last read page number
 	LastPageSize   uint32 // number of items per page
+	AtTx           uint64 // transaction the documents are read at
 }
 
 type Session struct {
@@ -57,11 +65,26 @@ type Session struct {
 	creationTime     time.Time
 	lastActivityTime time.Time
 	transactions     map[string]transactions.Transaction
-	documentReaders  *cache.Cache // track searchID to document.DocumentReader
+	expiredTxs       map[string]struct{} // IDs of the transactions rolled back because of a timeout
+	documentReaders  *cache.Cache        // track searchID to document.DocumentReader
+	idGenerator      IDGenerator
 	log              logger.Logger
+	closed           bool // set once the session has been released by the manager
+	readOnly         bool // only read-only transactions can be opened
+	parentID         string
+	// onTransactionEnd is set by the manager to be notified about the
+	// transactions rolled back by the session itself, it must not block
+	onTransactionEnd func(event *TransactionEvent)
+	// rwSlots is set by the manager when a single read-write transaction
+	// is allowed per database across all the sessions
+	rwSlots *readWriteSlots
+	// txOwners is set by the manager to index the transactions of all the sessions by their ID
+	txOwners *transactionOwners
+	// preparedStmts tracks the statements prepared within the session by their handle
+	preparedStmts *cache.Cache
 }
 
-func NewSession(sessionID string, user *auth.User, db database.DB, log logger.Logger) *Session {
+func NewSession(sessionID string, user *auth.User, db database.DB, idGenerator IDGenerator, log logger.Logger) *Session {
 	now := time.Now()
 	lruCache, _ := cache.NewCache(DefaultMaxDocumentReadersCacheSize)
 
@@ -72,34 +95,173 @@ func NewSession(sessionID string, user *auth.User, db database.DB, log logger.Lo
 		creationTime:     now,
 		lastActivityTime: now,
 		transactions:     make(map[string]transactions.Transaction),
+		expiredTxs:       make(map[string]struct{}),
+		idGenerator:      idGenerator,
 		log:              log,
 		documentReaders:  lruCache,
+		preparedStmts:    newPreparedStatementsCache(),
 	}
 }
 
+// NewTransaction opens a new SQL transaction against the session database
+// and registers it in the session. The SQL transaction is opened out of lock
+// (waiting for a snapshot may block for a while) and, if it can not be
+// registered afterwards, it is rolled back so no transaction is left dangling
+// in the engine without a session owning it.
 func (s *Session) NewTransaction(ctx context.Context, opts *sql.TxOptions) (transactions.Transaction, error) {
-	s.mux.Lock()
-	defer s.mux.Unlock()
+	if s.IsReadOnly() && (opts == nil || !opts.ReadOnly) {
+		return nil, ErrReadWriteTXNotAllowed
+	}
+
+	transactionID, err := s.idGenerator.NewTransactionID()
+	if err != nil {
+		s.log.Errorf("cant create transaction id: %v", err)
+		return nil, ErrCantCreateTransactionID
+	}
 
-	tx, err := transactions.NewTransaction(ctx, opts, s.database, s.id)
+	db := s.GetDatabase()
+
+	exclusive := s.rwSlots != nil && (opts == nil || !opts.ReadOnly)
+	if exclusive {
+		err = s.rwSlots.reserve(db.GetName(), transactionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := transactions.NewTransaction(ctx, transactionID, opts, db, s.GetID())
 	if err != nil {
+		if exclusive {
+			s.rwSlots.release(db.GetName(), transactionID)
+		}
 		return nil, err
 	}
 
-	s.transactions[tx.GetID()] = tx
+	err = s.addTransaction(tx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			s.log.Errorf("Error while rolling back unregistered transaction %s: %v", tx.GetID(), rerr)
+		}
+		if exclusive {
+			s.rwSlots.release(db.GetName(), transactionID)
+		}
+		return nil, err
+	}
+
+	if exclusive {
+		s.rwSlots.assign(db.GetName(), tx)
+	}
+
 	return tx, nil
 }
 
+func (s *Session) addTransaction(tx transactions.Transaction) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.closed {
+		// the session was released while the transaction was being opened,
+		// nobody would ever roll it back
+		return ErrSessionNotFound
+	}
+
+	if _, ok := s.transactions[tx.GetID()]; ok {
+		return ErrTransactionAlreadyPresent
+	}
+
+	s.transactions[tx.GetID()] = tx
+
+	if s.txOwners != nil {
+		s.txOwners.add(tx.GetID(), s.id)
+	}
+
+	return nil
+}
+
 func (s *Session) RemoveTransaction(transactionID string) error {
 	s.mux.Lock()
 	defer s.mux.Unlock()
 	return s.removeTransaction(transactionID)
 }
 
+// rollbackWithRetry rolls back the transaction, failures are retried as long as the transaction is still open.
+// It's called out of the session lock, as retries are delayed.
+func (s *Session) rollbackWithRetry(tx transactions.Transaction) error {
+	var err error
+
+	for attempt := 1; attempt <= rollbackAttempts; attempt++ {
+		err = tx.Rollback()
+		if err == nil || tx.IsClosed() {
+			return err
+		}
+
+		s.log.Warningf("Rollback attempt %d/%d of transaction %s failed: %v", attempt, rollbackAttempts, tx.GetID(), err)
+
+		if attempt < rollbackAttempts {
+			time.Sleep(rollbackRetryDelay * time.Duration(attempt))
+		}
+	}
+
+	return err
+}
+
+// not thread safe
+// takeTransactions removes from the session the transactions matching the filter,
+// so they can be rolled back by rollbackTakenTransactions once the lock is released
+func (s *Session) takeTransactions(filter func(tx transactions.Transaction) bool) []transactions.Transaction {
+	var txs []transactions.Transaction
+
+	for id, tx := range s.transactions {
+		if filter(tx) {
+			delete(s.transactions, id)
+			txs = append(txs, tx)
+		}
+	}
+
+	return txs
+}
+
+// rollbackTakenTransactions rolls back the transactions taken from the session.
+// They are released even if they can't be rolled back, so a persistent failure
+// can't keep their read-write slots taken.
+func (s *Session) rollbackTakenTransactions(txs []transactions.Transaction) error {
+	merr := multierr.NewMultiErr()
+
+	for _, tx := range txs {
+		// the transaction may have been closed in the meantime
+		if err := s.rollbackWithRetry(tx); err != nil && !tx.IsClosed() {
+			s.log.Errorf("Error while rolling back transaction %s, discarding it: %v", tx.GetID(), err)
+			merr.Append(err)
+		}
+
+		s.releaseTransaction(tx)
+
+		s.notifyRollback(tx)
+	}
+
+	return merr.Reduce()
+}
+
+// releaseTransaction frees the owner entry and the read-write slot the transaction may hold
+func (s *Session) releaseTransaction(tx transactions.Transaction) {
+	if s.txOwners != nil {
+		s.txOwners.remove(tx.GetID())
+	}
+
+	if s.rwSlots != nil && !tx.IsReadOnly() {
+		s.rwSlots.release(tx.Database().GetName(), tx.GetID())
+	}
+}
+
 // not thread safe
 func (s *Session) removeTransaction(transactionID string) error {
 	if _, ok := s.transactions[transactionID]; ok {
 		delete(s.transactions, transactionID)
+
+		if s.txOwners != nil {
+			s.txOwners.remove(transactionID)
+		}
+
 		return nil
 	}
 	return ErrTransactionNotFound
@@ -132,27 +294,101 @@ func (s *Session) CloseDocumentReaders() error {
 
 func (s *Session) RollbackTransactions() error {
 	s.mux.Lock()
-	defer s.mux.Unlock()
-
-	merr := multierr.NewMultiErr()
+	txs := s.takeTransactions(func(tx transactions.Transaction) bool { return true })
+	s.mux.Unlock()
 
-	for _, tx := range s.transactions {
+	for _, tx := range txs {
 		s.log.Debugf("Deleting transaction %s", tx.GetID())
+	}
 
-		if err := tx.Rollback(); err != nil {
-			s.log.Errorf("Error while rolling back transaction %s: %v", tx.GetID(), err)
-			merr.Append(err)
-			continue
+	return s.rollbackTakenTransactions(txs)
+}
+
+// RollbackReadWriteTransactions rolls back and removes the read-write
+// transactions of the session, read-only transactions are left untouched.
+func (s *Session) RollbackReadWriteTransactions() error {
+	s.mux.Lock()
+	txs := s.takeTransactions(func(tx transactions.Transaction) bool { return !tx.IsReadOnly() })
+	s.mux.Unlock()
+
+	err := s.rollbackTakenTransactions(txs)
+
+	for _, tx := range txs {
+		s.log.Infof("rolled back read-write transaction %s of inactive session %s", tx.GetID(), s.id)
+	}
+
+	return err
+}
+
+// RollbackExpiredTransactions rolls back and removes the transactions open for longer
+// than the timeout corresponding to their kind. Further uses of their IDs
+// fail with ErrTransactionExpired.
+func (s *Session) RollbackExpiredTransactions(now time.Time, readWriteTimeout, readOnlyTimeout time.Duration) error {
+	s.mux.Lock()
+	txs := s.takeTransactions(func(tx transactions.Transaction) bool {
+		timeout := readWriteTimeout
+		if tx.IsReadOnly() {
+			timeout = readOnlyTimeout
 		}
 
-		if err := s.removeTransaction(tx.GetID()); err != nil {
-			s.log.Errorf("Error while removing transaction %s: %v", tx.GetID(), err)
-			merr.Append(err)
-			continue
+		return now.Sub(tx.CreatedAt()) > timeout
+	})
+
+	for _, tx := range txs {
+		s.expiredTxs[tx.GetID()] = struct{}{}
+	}
+	s.mux.Unlock()
+
+	err := s.rollbackTakenTransactions(txs)
+
+	for _, tx := range txs {
+		s.log.Infof("rolled back transaction %s of session %s, timeout exceeded", tx.GetID(), s.id)
+	}
+
+	return err
+}
+
+// requireSnapshotOf makes the read-only transactions of the session observe
+// the given committed transaction from their next query on
+func (s *Session) requireSnapshotOf(txID uint64) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	for _, tx := range s.transactions {
+		if tx.IsReadOnly() {
+			tx.SetSnapshotMustIncludeTxID(txID)
 		}
 	}
+}
 
-	return merr.Reduce()
+// not thread safe
+func (s *Session) notifyRollback(tx transactions.Transaction) {
+	if s.onTransactionEnd != nil {
+		s.onTransactionEnd(newTransactionEvent(tx, false, nil))
+	}
+}
+
+// close marks the session as released, transactions can no longer be
+// registered on it.
+func (s *Session) close() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.closed = true
+}
+
+// IsReadOnly returns true if only read-only transactions can be opened in the session
+func (s *Session) IsReadOnly() bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.readOnly
+}
+
+// GetParentID returns the id of the session this one was derived from,
+// empty if it was not derived from another session
+func (s *Session) GetParentID() string {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.parentID
 }
 
 func (s *Session) GetID() string {
@@ -167,6 +403,9 @@ func (s *Session) GetTransaction(transactionID string) (transactions.Transaction
 
 	tx, ok := s.transactions[transactionID]
 	if !ok {
+		if _, expired := s.expiredTxs[transactionID]; expired {
+			return nil, ErrTransactionExpired
+		}
 		return nil, transactions.ErrTransactionNotFound
 	}
 
@@ -205,6 +444,22 @@ func GetTransactionIDFromContext(ctx context.Context) (string, error) {
 	return transactionID, nil
 }
 
+// GetSessionAndTransactionIDFromContext returns both the session and the transaction IDs
+// provided in the context, an error is returned if any of them is missing
+func GetSessionAndTransactionIDFromContext(ctx context.Context) (sessionID, transactionID string, err error) {
+	sessionID, err = GetSessionIDFromContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	transactionID, err = GetTransactionIDFromContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	return sessionID, transactionID, nil
+}
+
 func (s *Session) GetUser() *auth.User {
 	s.mux.RLock()
 	defer s.mux.RUnlock()
@@ -221,6 +476,8 @@ func (s *Session) SetDatabase(db database.DB) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
 	s.database = db
+	// statements were prepared against the schema of the previous database
+	s.preparedStmts = newPreparedStatementsCache()
 }
 
 func (s *Session) GetLastActivityTime() time.Time {
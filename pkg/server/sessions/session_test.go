@@ -18,18 +18,26 @@ package sessions
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
 	stdos "os"
 	"testing"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/server/sessions/internal/transactions"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestNewSession(t *testing.T) {
-	sess := NewSession("sessID", &auth.User{}, nil, logger.NewSimpleLogger("test", stdos.Stdout))
+	sess := NewSession("sessID", &auth.User{}, nil, NewDefaultIDGenerator(rand.Reader), logger.NewSimpleLogger("test", stdos.Stdout))
 	require.NotNil(t, sess)
 	require.Less(t, sess.GetCreationTime(), time.Now())
 	require.Less(t, sess.GetLastActivityTime(), time.Now())
@@ -48,3 +56,402 @@ func TestGetSessionIDFromContext(t *testing.T) {
 	_, err = GetSessionIDFromContext(metadata.NewIncomingContext(ctx, metadata.Pairs()))
 	require.ErrorIs(t, ErrNoSessionAuthDataProvided, err)
 }
+
+// sqlTxTrackingDB records every SQL transaction opened through it
+type sqlTxTrackingDB struct {
+	database.DB
+	sqlTxs []*sql.SQLTx
+}
+
+func (db *sqlTxTrackingDB) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (*sql.SQLTx, error) {
+	tx, err := db.DB.NewSQLTx(ctx, opts)
+	if err == nil {
+		db.sqlTxs = append(db.sqlTxs, tx)
+	}
+	return tx, err
+}
+
+func TestNewTransactionOnReleasedSession(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackingDB := &sqlTxTrackingDB{DB: db}
+
+	sess := NewSession("sessID", &auth.User{}, trackingDB, NewDefaultIDGenerator(rand.Reader), logger.NewSimpleLogger("test", stdos.Stdout))
+
+	err = releaseSession(sess)
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	require.Nil(t, tx)
+	require.Empty(t, sess.transactions)
+
+	require.Len(t, trackingDB.sqlTxs, 1)
+	require.True(t, trackingDB.sqlTxs[0].Closed())
+}
+
+func TestOngoingReadWriteTxError(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var err error = &OngoingReadWriteTxError{
+		TransactionID: "tx1",
+		CreatedAt:     createdAt,
+	}
+	require.ErrorIs(t, err, ErrOngoingReadWriteTx)
+	require.Contains(t, err.Error(), "tx1")
+	require.Contains(t, err.Error(), "2026-01-02T03:04:05Z")
+
+	var txErr *OngoingReadWriteTxError
+	require.ErrorAs(t, err, &txErr)
+	require.Equal(t, "tx1", txErr.TransactionID)
+	require.Equal(t, createdAt, txErr.CreatedAt)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+// failingRollbackTx fails to roll back the first failures times, a negative value fails forever
+type failingRollbackTx struct {
+	transactions.Transaction
+	failures  int
+	rollbacks int
+}
+
+func (tx *failingRollbackTx) Rollback() error {
+	tx.rollbacks++
+
+	if tx.failures != 0 {
+		tx.failures--
+		return errors.New("rollback failure")
+	}
+	return tx.Transaction.Rollback()
+}
+
+func TestRollbackTransactionsWithFailures(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions().WithExclusiveReadWriteTx(true))
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	t.Run("transient failures are retried", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		failingTx := &failingRollbackTx{Transaction: tx, failures: rollbackAttempts - 1}
+		sess.transactions[tx.GetID()] = failingTx
+
+		err = sess.RollbackTransactions()
+		require.NoError(t, err)
+		require.Equal(t, rollbackAttempts, failingTx.rollbacks)
+		require.True(t, tx.IsClosed())
+		require.Empty(t, sess.transactions)
+	})
+
+	t.Run("persistent failures free the read-write slot", func(t *testing.T) {
+		tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		failingTx := &failingRollbackTx{Transaction: tx, failures: -1}
+		sess.transactions[tx.GetID()] = failingTx
+
+		err = sess.RollbackTransactions()
+		require.ErrorContains(t, err, "rollback failure")
+		require.Equal(t, rollbackAttempts, failingTx.rollbacks)
+		require.False(t, tx.IsClosed())
+		require.Empty(t, sess.transactions)
+
+		tx, err = sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = sess.RollbackTransactions()
+		require.NoError(t, err)
+	})
+}
+
+func TestRollbackReadWriteTransactionsWithFailures(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions().WithExclusiveReadWriteTx(true))
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	roTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	failingTx := &failingRollbackTx{Transaction: tx, failures: -1}
+	sess.transactions[tx.GetID()] = failingTx
+
+	err = sess.RollbackReadWriteTransactions()
+	require.ErrorContains(t, err, "rollback failure")
+	require.Equal(t, rollbackAttempts, failingTx.rollbacks)
+
+	_, err = sess.GetTransaction(tx.GetID())
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+
+	_, err = sess.GetTransaction(roTx.GetID())
+	require.NoError(t, err)
+
+	// the read-write slot was freed
+	tx, err = sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	require.NoError(t, sess.RollbackTransactions())
+}
+
+func TestRollbackExpiredTransactionsWithFailures(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions().WithExclusiveReadWriteTx(true))
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	failingTx := &failingRollbackTx{Transaction: tx, failures: -1}
+	sess.transactions[tx.GetID()] = failingTx
+
+	err = sess.RollbackExpiredTransactions(time.Now().Add(time.Hour), time.Minute, time.Minute)
+	require.ErrorContains(t, err, "rollback failure")
+	require.Equal(t, rollbackAttempts, failingTx.rollbacks)
+
+	_, err = sess.GetTransaction(tx.GetID())
+	require.ErrorIs(t, err, ErrTransactionExpired)
+
+	// the read-write slot was freed
+	_, err = sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	require.NoError(t, sess.RollbackTransactions())
+}
+
+// blockingRollbackTx waits for release before rolling back
+type blockingRollbackTx struct {
+	transactions.Transaction
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (tx *blockingRollbackTx) Rollback() error {
+	close(tx.entered)
+	<-tx.release
+	return tx.Transaction.Rollback()
+}
+
+func TestRollbackExpiredTransactionsOutOfLock(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := NewManager(DefaultOptions())
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db)
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+
+	blockingTx := &blockingRollbackTx{Transaction: tx, entered: make(chan struct{}), release: make(chan struct{})}
+	sess.transactions[tx.GetID()] = blockingTx
+
+	rolledBack := make(chan error)
+	go func() {
+		rolledBack <- sess.RollbackExpiredTransactions(time.Now().Add(time.Hour), time.Minute, time.Minute)
+	}()
+
+	<-blockingTx.entered
+
+	// the session is usable while the rollback is in progress
+	_, err = sess.GetTransaction(tx.GetID())
+	require.ErrorIs(t, err, ErrTransactionExpired)
+
+	roTx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	close(blockingTx.release)
+
+	require.NoError(t, <-rolledBack)
+	require.True(t, tx.IsClosed())
+
+	_, err = sess.GetTransaction(roTx.GetID())
+	require.NoError(t, err)
+
+	require.NoError(t, sess.RollbackTransactions())
+}
+
+func TestDiscardTransactionAfterDatabaseChange(t *testing.T) {
+	db1, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db1.Close()
+
+	db2, err := database.NewDB("db2", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db2.Close()
+
+	m, err := NewManager(DefaultOptions().WithExclusiveReadWriteTx(true))
+	require.NoError(t, err)
+
+	sess, err := m.NewSession(&auth.User{}, db1)
+	require.NoError(t, err)
+
+	tx, err := sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	// the transaction is left open, so the slot can only be freed by releasing it
+	sess.transactions[tx.GetID()] = &failingRollbackTx{Transaction: tx, failures: -1}
+
+	sess.SetDatabase(db2)
+
+	require.ErrorContains(t, sess.RollbackTransactions(), "rollback failure")
+
+	// the slot held on the database of the transaction was released
+	sess.SetDatabase(db1)
+
+	tx, err = sess.NewTransaction(context.Background(), sql.DefaultTxOptions())
+	require.NoError(t, err)
+	require.Equal(t, "db1", tx.Database().GetName())
+
+	require.NoError(t, sess.RollbackTransactions())
+}
+
+func TestSessionPreparedStatements(t *testing.T) {
+	db, err := database.NewDB("db1", nil, database.DefaultOptions().WithDBRootPath(t.TempDir()), logger.NewSimpleLogger("test", stdos.Stdout))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sess := NewSession("sessID", &auth.User{}, db, NewDefaultIDGenerator(rand.Reader), logger.NewSimpleLogger("test", stdos.Stdout))
+
+	ctx := context.Background()
+
+	createTable, err := sess.PrepareStatement(ctx, "CREATE TABLE mytable(id INTEGER, title VARCHAR, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	_, err = sess.ExecPrepared(ctx, createTable.Handle(), nil)
+	require.NoError(t, err)
+
+	insert, err := sess.PrepareStatement(ctx, "INSERT INTO mytable(id, title) VALUES (@id, @title)")
+	require.NoError(t, err)
+	require.Equal(t, map[string]sql.SQLValueType{"id": sql.IntegerType, "title": sql.VarcharType}, insert.Params())
+
+	t.Run("preparing the same statement returns the prepared one", func(t *testing.T) {
+		ps, err := sess.PrepareStatement(ctx, insert.SQL())
+		require.NoError(t, err)
+		require.Same(t, insert, ps)
+	})
+
+	res, err := sess.ExecPrepared(ctx, insert.Handle(), map[string]interface{}{"id": 1, "title": "title1"})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.UpdatedRows)
+
+	query, err := sess.PrepareStatement(ctx, "SELECT title FROM mytable WHERE id = @id")
+	require.NoError(t, err)
+
+	readTitle := func(ctx context.Context, id int) (string, error) {
+		reader, err := sess.QueryPrepared(ctx, query.Handle(), map[string]interface{}{"id": id})
+		if err != nil {
+			return "", err
+		}
+		defer reader.Close()
+
+		row, err := reader.Read(ctx)
+		if err != nil {
+			return "", err
+		}
+		return row.ValuesByPosition[0].RawValue().(string), nil
+	}
+
+	title, err := readTitle(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "title1", title)
+
+	t.Run("statements are run within the transaction of the context", func(t *testing.T) {
+		tx, err := sess.NewTransaction(ctx, sql.DefaultTxOptions())
+		require.NoError(t, err)
+
+		txCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("transactionid", tx.GetID()))
+
+		res, err := sess.ExecPrepared(txCtx, insert.Handle(), map[string]interface{}{"id": 2, "title": "title2"})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.UpdatedRows)
+
+		title, err := readTitle(txCtx, 2)
+		require.NoError(t, err)
+		require.Equal(t, "title2", title)
+
+		_, err = readTitle(ctx, 2)
+		require.ErrorIs(t, err, sql.ErrNoMoreRows)
+
+		_, err = tx.Commit(ctx)
+		require.NoError(t, err)
+
+		title, err = readTitle(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, "title2", title)
+
+		unknownTxCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("transactionid", "unknown"))
+
+		_, err = sess.ExecPrepared(unknownTxCtx, insert.Handle(), map[string]interface{}{"id": 3, "title": "title3"})
+		require.ErrorIs(t, err, ErrTransactionNotFound)
+	})
+
+	t.Run("invalid statements", func(t *testing.T) {
+		_, err := sess.PrepareStatement(ctx, "INVALID SQL")
+		require.Error(t, err)
+
+		_, err = sess.QueryPrepared(ctx, insert.Handle(), nil)
+		require.ErrorIs(t, err, sql.ErrExpectingDQLStmt)
+
+		_, err = sess.ExecPrepared(ctx, "unknown", nil)
+		require.ErrorIs(t, err, ErrPreparedStatementNotFound)
+	})
+
+	t.Run("read-only sessions can not commit statements", func(t *testing.T) {
+		sess.readOnly = true
+		defer func() { sess.readOnly = false }()
+
+		_, err := sess.ExecPrepared(ctx, insert.Handle(), map[string]interface{}{"id": 3, "title": "title3"})
+		require.ErrorIs(t, err, ErrReadWriteTXNotAllowed)
+
+		_, err = readTitle(ctx, 1)
+		require.NoError(t, err)
+	})
+
+	t.Run("the number of prepared statements is bounded", func(t *testing.T) {
+		for i := 0; i < DefaultMaxPreparedStatements; i++ {
+			_, err := sess.PrepareStatement(ctx, fmt.Sprintf("SELECT id FROM mytable WHERE id = %d", i))
+			require.NoError(t, err)
+		}
+		require.Equal(t, DefaultMaxPreparedStatements, sess.preparedStmts.EntriesCount())
+	})
+
+	t.Run("prepared statements are released when the database is changed", func(t *testing.T) {
+		sess.SetDatabase(db)
+
+		_, err := sess.QueryPrepared(ctx, query.Handle(), map[string]interface{}{"id": 1})
+		require.ErrorIs(t, err, ErrPreparedStatementNotFound)
+	})
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import "sync"
+
+// transactionOwners maps the ID of each open transaction to the session holding it,
+// so the owner of a transaction can be found without looking up every session
+type transactionOwners struct {
+	mu     sync.RWMutex
+	owners map[string]string
+}
+
+func newTransactionOwners() *transactionOwners {
+	return &transactionOwners{owners: make(map[string]string)}
+}
+
+func (o *transactionOwners) add(transactionID, sessionID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.owners[transactionID] = sessionID
+}
+
+func (o *transactionOwners) remove(transactionID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.owners, transactionID)
+}
+
+// owner returns the ID of the session holding the transaction, if any
+func (o *transactionOwners) owner(transactionID string) (string, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	sessionID, ok := o.owners[transactionID]
+	return sessionID, ok
+}
@@ -18,6 +18,8 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
@@ -73,3 +75,84 @@ func TestServerCurrentStateSigned(t *testing.T) {
 	err = signer.Verify(state.ToBytes(), state.Signature.Signature, ecdsaPK)
 	require.NoError(t, err)
 }
+
+func TestServerCurrentStateSignedUnderConcurrentWrites(t *testing.T) {
+	dir := t.TempDir()
+
+	sig, err := signer.NewSigner("./../../test/signer/ec3.key")
+	require.NoError(t, err)
+
+	s := DefaultServer()
+	s = s.WithOptions(DefaultOptions().WithDir(dir).WithAuth(false).WithSigningKey("foo")).WithStateSigner(NewStateSigner(sig)).(*ImmuServer)
+
+	err = s.loadSystemDatabase(dir, nil, s.Options.AdminPassword, false)
+	require.NoError(t, err)
+
+	err = s.loadDefaultDatabase(dir, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	const writers = 8
+	const writesPerWriter = 20
+
+	var writersWg sync.WaitGroup
+	writersWg.Add(writers)
+
+	for w := 0; w < writers; w++ {
+		go func(w int) {
+			defer writersWg.Done()
+
+			for i := 0; i < writesPerWriter; i++ {
+				_, err := s.Set(ctx, &schema.SetRequest{
+					KVs: []*schema.KeyValue{
+						{
+							Key:   []byte(fmt.Sprintf("key-%d-%d", w, i)),
+							Value: []byte(fmt.Sprintf("value-%d-%d", w, i)),
+						},
+					},
+				})
+				require.NoError(t, err)
+			}
+		}(w)
+	}
+
+	writesDone := make(chan struct{})
+	go func() {
+		writersWg.Wait()
+		close(writesDone)
+	}()
+
+	var states []*schema.ImmutableState
+
+	for done := false; !done; {
+		select {
+		case <-writesDone:
+			done = true
+		default:
+		}
+
+		state, err := s.CurrentState(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+
+		states = append(states, state)
+	}
+
+	ecdsaPK, err := signer.UnmarshalKey(states[0].Signature.PublicKey)
+	require.NoError(t, err)
+
+	for _, state := range states {
+		require.NoError(t, state.CheckSignature(ecdsaPK))
+		require.LessOrEqual(t, state.TxId, state.PrecommittedTxId)
+
+		if state.TxId == 0 {
+			continue
+		}
+
+		tx, err := s.TxById(ctx, &schema.TxRequest{Tx: state.TxId})
+		require.NoError(t, err)
+
+		alh := schema.TxHeaderFromProto(tx.Header).Alh()
+		require.Equal(t, alh[:], state.TxHash)
+	}
+}
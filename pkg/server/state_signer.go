@@ -17,6 +17,9 @@ limitations under the License.
 package server
 
 import (
+	"bytes"
+	"sync"
+
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/signer"
@@ -50,3 +53,59 @@ func (sts *stateSigner) Sign(state *schema.ImmutableState) error {
 
 	return err
 }
+
+// timestampingStateSigner attaches a timestamp token issued over the state to the signature
+// produced by the wrapped state signer. Tokens are requested while signing, so a timestamp
+// authority failure makes the signature fail.
+type timestampingStateSigner struct {
+	stateSigner StateSigner
+	timestamper signer.Timestamper
+
+	// the token issued for the last state of each database is reused until the state changes
+	mutex      sync.Mutex
+	lastTokens map[string]*timestampedState
+}
+
+type timestampedState struct {
+	payload []byte
+	token   []byte
+}
+
+func NewTimestampingStateSigner(stateSigner StateSigner, timestamper signer.Timestamper) *timestampingStateSigner {
+	return &timestampingStateSigner{
+		stateSigner: stateSigner,
+		timestamper: timestamper,
+		lastTokens:  make(map[string]*timestampedState),
+	}
+}
+
+func (sts *timestampingStateSigner) Sign(state *schema.ImmutableState) error {
+	err := sts.stateSigner.Sign(state)
+	if err != nil {
+		return err
+	}
+
+	payload := state.ToBytes()
+
+	sts.mutex.Lock()
+	last, ok := sts.lastTokens[state.Db]
+	sts.mutex.Unlock()
+
+	if ok && bytes.Equal(last.payload, payload) {
+		state.Signature.TimestampToken = last.token
+		return nil
+	}
+
+	token, err := sts.timestamper.Timestamp(payload)
+	if err != nil {
+		return err
+	}
+
+	sts.mutex.Lock()
+	sts.lastTokens[state.Db] = &timestampedState{payload: payload, token: token}
+	sts.mutex.Unlock()
+
+	state.Signature.TimestampToken = token
+
+	return nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/store"
@@ -46,3 +47,49 @@ func TestStateSigner_Err(t *testing.T) {
 	err := stSigner.Sign(nil)
 	require.ErrorIs(t, err, store.ErrIllegalArguments)
 }
+
+type timestamperMock struct {
+	calls int
+	err   error
+}
+
+func (m *timestamperMock) Timestamp(payload []byte) ([]byte, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.calls++
+	return append([]byte("token:"), payload...), nil
+}
+
+func TestTimestampingStateSigner_Sign(t *testing.T) {
+	s, _ := signer.NewSigner("./../../test/signer/ec3.key")
+	timestamper := &timestamperMock{}
+	stSigner := NewTimestampingStateSigner(NewStateSigner(s), timestamper)
+
+	state := &schema.ImmutableState{Db: "db1", TxId: 1, TxHash: make([]byte, 32)}
+	err := stSigner.Sign(state)
+	require.NoError(t, err)
+	require.NotEmpty(t, state.Signature.Signature)
+	require.Equal(t, append([]byte("token:"), state.ToBytes()...), state.Signature.TimestampToken)
+	require.Equal(t, 1, timestamper.calls)
+
+	// the token is reused while the state does not change
+	sameState := &schema.ImmutableState{Db: "db1", TxId: 1, TxHash: make([]byte, 32)}
+	err = stSigner.Sign(sameState)
+	require.NoError(t, err)
+	require.Equal(t, state.Signature.TimestampToken, sameState.Signature.TimestampToken)
+	require.Equal(t, 1, timestamper.calls)
+
+	newState := &schema.ImmutableState{Db: "db1", TxId: 2, TxHash: make([]byte, 32)}
+	err = stSigner.Sign(newState)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte("token:"), newState.ToBytes()...), newState.Signature.TimestampToken)
+	require.Equal(t, 2, timestamper.calls)
+
+	err = stSigner.Sign(nil)
+	require.ErrorIs(t, err, store.ErrIllegalArguments)
+
+	timestamper.err = errors.New("tsa unavailable")
+	err = stSigner.Sign(&schema.ImmutableState{Db: "db1", TxId: 3, TxHash: make([]byte, 32)})
+	require.ErrorIs(t, err, timestamper.err)
+}
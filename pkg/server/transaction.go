@@ -18,14 +18,20 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
+// MaxSinceTxWait is the maximum amount of time NewTx waits for the sinceTx transaction to be indexed
+const MaxSinceTxWait = 5 * time.Second
+
 // BeginTx creates a new transaction. Only one read-write transaction per session can be active at a time.
 func (s *ImmuServer) NewTx(ctx context.Context, request *schema.NewTxRequest) (*schema.NewTxResponse, error) {
 	if request == nil {
@@ -60,12 +66,58 @@ func (s *ImmuServer) NewTx(ctx context.Context, request *schema.NewTxRequest) (*
 
 	opts.UnsafeMVCC = request.UnsafeMVCC
 
+	if request.SinceTx > 0 {
+		err = waitForSinceTx(ctx, sess.GetDatabase(), request.SinceTx)
+		if err != nil {
+			return nil, err
+		}
+
+		mustIncludeTxID := opts.SnapshotMustIncludeTxID
+
+		opts.WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 {
+			if mustIncludeTxID != nil {
+				return max(mustIncludeTxID(lastPrecommittedTxID), request.SinceTx)
+			}
+			return request.SinceTx
+		})
+	}
+
 	tx, err := sess.NewTransaction(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return &schema.NewTxResponse{TransactionID: tx.GetID()}, nil
+	if request.SinceTx == 0 {
+		return &schema.NewTxResponse{TransactionID: tx.GetID()}, nil
+	}
+
+	// the snapshot is taken right away so that the transaction does not observe
+	// anything older than what the client was told
+	snapshotTxID, err := tx.SnapshotTxID()
+	if err != nil {
+		if rerr := s.SessManager.RollbackTransaction(tx); rerr != nil {
+			s.Logger.Errorf("error rolling back transaction %s: %v", tx.GetID(), rerr)
+		}
+		return nil, err
+	}
+
+	return &schema.NewTxResponse{
+		TransactionID: tx.GetID(),
+		SnapshotTxID:  snapshotTxID,
+	}, nil
+}
+
+// waitForSinceTx waits up to MaxSinceTxWait for the transaction to be indexed,
+// so a new transaction is able to observe it
+func waitForSinceTx(ctx context.Context, db database.DB, sinceTx uint64) error {
+	waitCtx, cancel := context.WithTimeout(ctx, MaxSinceTxWait)
+	defer cancel()
+
+	err := db.WaitForIndexingUpto(waitCtx, sinceTx)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("%w: transaction %d was not indexed within %s", ErrSinceTxNotIndexed, sinceTx, MaxSinceTxWait)
+	}
+	return err
 }
 
 func (s *ImmuServer) Commit(ctx context.Context, _ *empty.Empty) (*schema.CommittedSQLTx, error) {
@@ -109,27 +161,40 @@ func (s *ImmuServer) Rollback(ctx context.Context, _ *empty.Empty) (*empty.Empty
 	return new(empty.Empty), s.SessManager.RollbackTransaction(tx)
 }
 
-func (s *ImmuServer) TxSQLExec(ctx context.Context, request *schema.SQLExecRequest) (*empty.Empty, error) {
+func (s *ImmuServer) TxSQLExec(ctx context.Context, request *schema.SQLExecRequest) (*schema.TxSQLExecResult, error) {
 	if request == nil {
 		return nil, ErrIllegalArguments
 	}
 
 	if s.Options.GetMaintenance() {
-		return new(empty.Empty), ErrNotAllowedInMaintenanceMode
+		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
 	tx, err := s.SessManager.GetTransactionFromContext(ctx)
 	if err != nil {
-		return new(empty.Empty), err
+		return nil, err
 	}
 
-	res := tx.SQLExec(ctx, request)
+	res, err := tx.SQLExec(ctx, request)
 
 	if tx.IsClosed() {
 		s.SessManager.DeleteTransaction(tx)
 	}
 
-	return new(empty.Empty), res
+	if err != nil {
+		return nil, err
+	}
+
+	lastPKs := make(map[string]*schema.SQLValue, len(res.LastInsertedPKs))
+
+	for table, pk := range res.LastInsertedPKs {
+		lastPKs[table] = &schema.SQLValue{Value: &schema.SQLValue_N{N: pk}}
+	}
+
+	return &schema.TxSQLExecResult{
+		UpdatedRows:     uint32(res.UpdatedRows),
+		LastInsertedPKs: lastPKs,
+	}, nil
 }
 
 func (s *ImmuServer) TxSQLQuery(req *schema.SQLQueryRequest, srv schema.ImmuService_TxSQLQueryServer) error {
@@ -155,6 +155,9 @@ type ImmuServer struct {
 	truncators     map[string]*truncator.Truncator
 	truncatorMutex sync.Mutex
 
+	documentExpirationStop chan struct{}
+	documentExpirationDone chan struct{}
+
 	Logger      logger.Logger
 	Options     *Options
 	Listener    net.Listener
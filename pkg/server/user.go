@@ -272,13 +272,23 @@ func toSchemaUser(u *auth.User) (*schema.User, error) {
 		privileges[i] = &schema.SQLPrivilege{Database: p.Database, Privilege: p.Privilege}
 	}
 
+	var collectionPermissions []*schema.CollectionPermission
+	for _, p := range u.CollectionPermissions {
+		collectionPermissions = append(collectionPermissions, &schema.CollectionPermission{
+			Database:   p.Database,
+			Collection: p.Collection,
+			Permission: p.Permission,
+		})
+	}
+
 	return &schema.User{
-		User:          []byte(u.Username),
-		Createdat:     u.CreatedAt.String(),
-		Createdby:     u.CreatedBy,
-		Permissions:   permissions,
-		SqlPrivileges: privileges,
-		Active:        u.Active,
+		User:                  []byte(u.Username),
+		Createdat:             u.CreatedAt.String(),
+		Createdby:             u.CreatedBy,
+		Permissions:           permissions,
+		SqlPrivileges:         privileges,
+		Active:                u.Active,
+		CollectionPermissions: collectionPermissions,
 	}, nil
 }
 
@@ -448,6 +458,100 @@ func (s *ImmuServer) ChangePermission(ctx context.Context, r *schema.ChangePermi
 	return new(empty.Empty), nil
 }
 
+// ChangeCollectionPermission grant or revoke user permissions on collections
+func (s *ImmuServer) ChangeCollectionPermission(ctx context.Context, r *schema.ChangeCollectionPermissionRequest) (*empty.Empty, error) {
+	s.Logger.Debugf("ChangeCollectionPermission %+v", r)
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	// sanitize input
+	{
+		if len(r.Username) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "username can not be empty")
+		}
+
+		if len(r.Collection) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "collection can not be empty")
+		}
+
+		if _, err := s.dbList.GetByName(r.Database); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "database does not exist")
+		}
+
+		if (r.Action != schema.PermissionAction_GRANT) &&
+			(r.Action != schema.PermissionAction_REVOKE) {
+			return nil, status.Errorf(codes.InvalidArgument, "action not recognized")
+		}
+
+		if r.Action == schema.PermissionAction_GRANT &&
+			r.Permission != auth.PermissionR && r.Permission != auth.PermissionRW {
+			return nil, status.Errorf(codes.InvalidArgument, "unrecognized permission")
+		}
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//do not allow to change own permissions, user can lock itsself out
+	if r.Username == user.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "changing your own permissions is not allowed")
+	}
+
+	if r.Username == auth.SysAdminUsername {
+		return nil, status.Errorf(codes.InvalidArgument, "changing sysadmin permissions is not allowed")
+	}
+
+	// check if user exists
+	targetUser, err := s.getUser(ctx, []byte(r.Username))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", r.Username)
+	}
+
+	// target user should be active
+	if !targetUser.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "user %s is not active", r.Username)
+	}
+
+	// target user should have permission on the requested database
+	if targetUser.WhichPermission(r.Database) == auth.PermissionNone {
+		return nil, status.Errorf(codes.FailedPrecondition, "user %s doesn't have permission on database %s", r.Username, r.Database)
+	}
+
+	// check if requesting user has permission on this database
+	if !user.IsSysAdmin {
+		if !user.HasPermission(r.Database, auth.PermissionAdmin) {
+			return nil, status.Errorf(codes.PermissionDenied, "you do not have permission on this database")
+		}
+	}
+
+	if r.Action == schema.PermissionAction_REVOKE {
+		targetUser.RevokeCollectionPermission(r.Database, r.Collection)
+	} else {
+		targetUser.GrantCollectionPermission(r.Database, r.Collection, r.Permission)
+	}
+
+	targetUser.CreatedBy = user.Username
+	targetUser.CreatedAt = time.Now()
+
+	if err := s.saveUser(ctx, targetUser); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("permissions of user %s for collection %s of database %s was changed by user %s", targetUser.Username, r.Collection, r.Database, user.Username)
+
+	// remove user from loggedin users
+	s.removeUserFromLoginList(targetUser.Username)
+
+	// terminate active sessions for this user
+	s.SessManager.CloseSessionsForUser(targetUser.Username)
+
+	return new(empty.Empty), nil
+}
+
 // SetActiveUser activate or deactivate a user
 func (s *ImmuServer) SetActiveUser(ctx context.Context, r *schema.SetActiveUserRequest) (*empty.Empty, error) {
 	s.Logger.Debugf("SetActiveUser")
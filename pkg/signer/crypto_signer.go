@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+)
+
+type cryptoSigner struct {
+	rand      io.Reader
+	signer    crypto.Signer
+	publicKey []byte
+}
+
+// NewCryptoSigner returns a signer delegating the signature to a crypto.Signer holding an ECDSA P-256 key,
+// the key may be kept outside of the process (e.g. in an HSM). Produced signatures are verified with Verify,
+// as the ones produced by a file-based signer.
+func NewCryptoSigner(signer crypto.Signer) (Signer, error) {
+	publicKey, ok := signer.Public().(*ecdsa.PublicKey)
+	if !ok || publicKey.Curve != elliptic.P256() {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return cryptoSigner{
+		rand:      rand.Reader,
+		signer:    signer,
+		publicKey: elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y),
+	}, nil
+}
+
+func (sig cryptoSigner) Sign(payload []byte) ([]byte, []byte, error) {
+	hash := sha256.Sum256(payload)
+
+	// ECDSA crypto.Signer implementations return asn1 marshalled signatures
+	signature, err := sig.signer.Sign(sig.rand, hash[:], crypto.SHA256)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signature, sig.publicKey, nil
+}
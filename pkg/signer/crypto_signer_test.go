@@ -0,0 +1,74 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCryptoSigner(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	s, err := NewCryptoSigner(pk)
+	require.NoError(t, err)
+
+	payload := []byte(`mypayload`)
+
+	signature, publicKey, err := s.Sign(payload)
+	require.NoError(t, err)
+
+	pubKey, err := UnmarshalKey(publicKey)
+	require.NoError(t, err)
+	require.True(t, pk.PublicKey.Equal(pubKey))
+
+	err = Verify(payload, signature, pubKey)
+	require.NoError(t, err)
+
+	err = Verify([]byte(`tampered`), signature, pubKey)
+	require.ErrorIs(t, err, ErrKeyCannotBeVerified)
+}
+
+func TestCryptoSignerInvalidKey(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = NewCryptoSigner(pk)
+	require.ErrorIs(t, err, ErrInvalidPublicKey)
+}
+
+func TestPKCS11OptionsValidate(t *testing.T) {
+	var opts *PKCS11Options
+	require.ErrorIs(t, opts.Validate(), ErrInvalidPKCS11Options)
+
+	opts = &PKCS11Options{}
+	require.ErrorIs(t, opts.Validate(), ErrInvalidPKCS11Options)
+
+	opts.ModulePath = "/usr/lib/softhsm/libsofthsm2.so"
+	require.ErrorIs(t, opts.Validate(), ErrInvalidPKCS11Options)
+
+	opts.TokenLabel = "immudb"
+	require.ErrorIs(t, opts.Validate(), ErrInvalidPKCS11Options)
+
+	opts.KeyLabel = "state-signing"
+	require.NoError(t, opts.Validate())
+}
@@ -0,0 +1,199 @@
+//go:build pkcs11
+// +build pkcs11
+
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+// pkcs11Key is a crypto.Signer backed by a private key which never leaves the PKCS#11 token
+type pkcs11Key struct {
+	mutex      sync.Mutex // PKCS#11 sessions can not be used concurrently
+	ctx        *pkcs11.Ctx
+	session    pkcs11.SessionHandle
+	privateKey pkcs11.ObjectHandle
+	publicKey  *ecdsa.PublicKey
+}
+
+// NewPKCS11Signer returns a signer using an ECDSA P-256 key pair stored in a PKCS#11 token.
+// The module is kept loaded and the session opened for the lifetime of the process.
+func NewPKCS11Signer(opts *PKCS11Options) (Signer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := pkcs11.New(opts.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("unable to load pkcs11 module '%s'", opts.ModulePath)
+	}
+
+	key, err := openPKCS11Key(ctx, opts)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+
+	return NewCryptoSigner(key)
+}
+
+func openPKCS11Key(ctx *pkcs11.Ctx, opts *PKCS11Options) (*pkcs11Key, error) {
+	err := ctx.Initialize()
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := findPKCS11Slot(ctx, opts.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.Login(session, pkcs11.CKU_USER, opts.PIN)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return nil, err
+	}
+
+	privateKey, err := findPKCS11Object(ctx, session, pkcs11.CKO_PRIVATE_KEY, opts.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyHandle, err := findPKCS11Object(ctx, session, pkcs11.CKO_PUBLIC_KEY, opts.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := ctx.GetAttributeValue(session, publicKeyHandle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the point is usually DER-encoded as an octet string
+	ecPoint := attrs[0].Value
+
+	var rawPoint []byte
+	if rest, err := asn1.Unmarshal(ecPoint, &rawPoint); err == nil && len(rest) == 0 {
+		ecPoint = rawPoint
+	}
+
+	publicKey, err := UnmarshalKey(ecPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pkcs11Key{
+		ctx:        ctx,
+		session:    session,
+		privateKey: privateKey,
+		publicKey:  publicKey,
+	}, nil
+}
+
+func findPKCS11Slot(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, err
+		}
+
+		if strings.TrimSpace(info.Label) == tokenLabel {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("pkcs11 token '%s' not found", tokenLabel)
+}
+
+func findPKCS11Object(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, error) {
+	err := ctx.FindObjectsInit(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	objs, _, err := ctx.FindObjects(session, 2)
+
+	if ferr := ctx.FindObjectsFinal(session); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if len(objs) != 1 {
+		return 0, fmt.Errorf("expected exactly one pkcs11 key labelled '%s' but found %d", label, len(objs))
+	}
+
+	return objs[0], nil
+}
+
+func (k *pkcs11Key) Public() crypto.PublicKey {
+	return k.publicKey
+}
+
+// Sign signs the digest within the token and returns the asn1 marshalled signature
+func (k *pkcs11Key) Sign(_ io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	err := k.ctx.SignInit(k.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, k.privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// PKCS#11 returns the raw concatenation of r and s
+	sig, err := k.ctx.Sign(k.session, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sig) == 0 || len(sig)%2 != 0 {
+		return nil, fmt.Errorf("unexpected pkcs11 signature length: %d", len(sig))
+	}
+
+	return asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(sig[:len(sig)/2]),
+		S: new(big.Int).SetBytes(sig[len(sig)/2:]),
+	})
+}
@@ -0,0 +1,28 @@
+//go:build !pkcs11
+// +build !pkcs11
+
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+// NewPKCS11Signer is only available when built with the pkcs11 tag
+func NewPKCS11Signer(opts *PKCS11Options) (Signer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, ErrPKCS11NotSupported
+}
@@ -0,0 +1,38 @@
+//go:build !pkcs11
+// +build !pkcs11
+
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPKCS11SignerNotSupported(t *testing.T) {
+	_, err := NewPKCS11Signer(&PKCS11Options{})
+	require.ErrorIs(t, err, ErrInvalidPKCS11Options)
+
+	_, err = NewPKCS11Signer(&PKCS11Options{
+		ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+		TokenLabel: "immudb",
+		KeyLabel:   "state-signing",
+	})
+	require.ErrorIs(t, err, ErrPKCS11NotSupported)
+}
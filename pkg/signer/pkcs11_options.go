@@ -0,0 +1,53 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrPKCS11NotSupported = errors.New("pkcs11 support not built-in, build with the pkcs11 tag")
+var ErrInvalidPKCS11Options = errors.New("invalid pkcs11 options")
+
+// PKCS11Options locates an ECDSA P-256 key pair stored in a PKCS#11 token
+type PKCS11Options struct {
+	// ModulePath is the path of the PKCS#11 library provided by the HSM vendor
+	ModulePath string
+	// TokenLabel is the label of the token holding the key pair
+	TokenLabel string
+	// KeyLabel is the label shared by the private and the public key
+	KeyLabel string
+	// PIN is the user PIN used to log into the token
+	PIN string `json:"-"`
+}
+
+func (opts *PKCS11Options) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil options", ErrInvalidPKCS11Options)
+	}
+	if opts.ModulePath == "" {
+		return fmt.Errorf("%w: module path not specified", ErrInvalidPKCS11Options)
+	}
+	if opts.TokenLabel == "" {
+		return fmt.Errorf("%w: token label not specified", ErrInvalidPKCS11Options)
+	}
+	if opts.KeyLabel == "" {
+		return fmt.Errorf("%w: key label not specified", ErrInvalidPKCS11Options)
+	}
+	return nil
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"time"
+)
+
+// DefaultTSATimeout is the default maximum amount of time to wait for a timestamp authority response
+const DefaultTSATimeout = 10 * time.Second
+
+// maxTSAResponseSize limits the size of the responses read from a timestamp authority
+const maxTSAResponseSize = 1 << 20
+
+var ErrTimestampRejected = errors.New("timestamp request rejected by the timestamp authority")
+var ErrInvalidTimestampToken = errors.New("invalid timestamp token")
+var ErrTimestampCannotBeVerified = errors.New("timestamp cannot be verified")
+
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidRSAEncryption   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidSHA256WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidSHA384WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}
+	oidSHA512WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}
+	oidECPublicKey     = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+)
+
+// Timestamper obtains a trusted timestamp token over a payload
+type Timestamper interface {
+	Timestamp(payload []byte) (token []byte, err error)
+}
+
+// RFC 3161 structures
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type timeStampReq struct {
+	Version        int
+	MessageImprint messageImprint
+	ReqPolicy      asn1.ObjectIdentifier `asn1:"optional"`
+	Nonce          *big.Int              `asn1:"optional"`
+	CertReq        bool                  `asn1:"optional,default:false"`
+}
+
+type pkiStatusInfo struct {
+	Status       int
+	StatusString []string       `asn1:"optional"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+type timeStampResp struct {
+	Status         pkiStatusInfo
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type accuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint messageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time     `asn1:"generalized"`
+	Accuracy       accuracy      `asn1:"optional"`
+	Ordering       bool          `asn1:"optional,default:false"`
+	Nonce          *big.Int      `asn1:"optional"`
+	TSA            asn1.RawValue `asn1:"optional,tag:0"`
+	Extensions     asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+// RFC 5652 (CMS) structures
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type encapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+type tsaClient struct {
+	url        string
+	httpClient *http.Client
+	rand       io.Reader
+}
+
+// NewTSAClient returns a Timestamper requesting RFC 3161 timestamp tokens to the timestamp authority at the given url.
+// If httpClient is nil, a client with DefaultTSATimeout is used.
+func NewTSAClient(url string, httpClient *http.Client) Timestamper {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTSATimeout}
+	}
+	return &tsaClient{url: url, httpClient: httpClient, rand: rand.Reader}
+}
+
+// Timestamp requests a timestamp token over the SHA-256 digest of the payload.
+// The returned token is the DER encoded CMS SignedData produced by the timestamp authority.
+func (c *tsaClient) Timestamp(payload []byte) ([]byte, error) {
+	digest := sha256.Sum256(payload)
+
+	nonce, err := rand.Int(c.rand, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := asn1.Marshal(timeStampReq{
+		Version: 1,
+		MessageImprint: messageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+			HashedMessage: digest[:],
+		},
+		Nonce:   nonce,
+		CertReq: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Post(c.url, "application/timestamp-query", bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: unexpected http status %s", ErrTimestampRejected, httpResp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxTSAResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp timeStampResp
+
+	rest, err := asn1.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%w: trailing data after response", ErrInvalidTimestampToken)
+	}
+
+	// 0: granted, 1: granted with modifications
+	if resp.Status.Status != 0 && resp.Status.Status != 1 {
+		return nil, fmt.Errorf("%w: status %d %v", ErrTimestampRejected, resp.Status.Status, resp.Status.StatusString)
+	}
+
+	token := resp.TimeStampToken.FullBytes
+
+	info, _, err := parseTimestampToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkMessageImprint(info, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Nonce == nil || info.Nonce.Cmp(nonce) != 0 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidTimestampToken)
+	}
+
+	return token, nil
+}
+
+// VerifyTimestamp verifies the timestamp token was issued over the payload by a timestamp authority
+// trusted by roots (system roots are used if nil) and returns the time it attests.
+func VerifyTimestamp(payload []byte, token []byte, roots *x509.CertPool) (time.Time, error) {
+	info, certs, err := parseTimestampToken(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = checkMessageImprint(info, payload)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   info.GenTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrTimestampCannotBeVerified, err)
+	}
+
+	return info.GenTime, nil
+}
+
+// ParseCertPoolFile returns the pool of the PEM encoded certificates stored in the file
+func ParseCertPoolFile(filePath string) (*x509.CertPool, error) {
+	pemCerts, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return nil, errors.New("no certificate found in provided file")
+	}
+
+	return pool, nil
+}
+
+// parseTimestampToken decodes the token and checks it was signed by the certificate it includes.
+// It returns the timestamp information and the included certificates, the signer one first.
+func parseTimestampToken(token []byte) (*tstInfo, []*x509.Certificate, error) {
+	var ci contentInfo
+
+	_, err := asn1.Unmarshal(token, &ci)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, nil, fmt.Errorf("%w: not a signed data", ErrInvalidTimestampToken)
+	}
+
+	var sd signedData
+
+	_, err = asn1.Unmarshal(ci.Content.Bytes, &sd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+	}
+	if !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) {
+		return nil, nil, fmt.Errorf("%w: unexpected content type", ErrInvalidTimestampToken)
+	}
+	if len(sd.SignerInfos) != 1 {
+		return nil, nil, fmt.Errorf("%w: exactly one signer expected", ErrInvalidTimestampToken)
+	}
+
+	var info tstInfo
+
+	_, err = asn1.Unmarshal(sd.EncapContentInfo.EContent, &info)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+	}
+
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+	}
+
+	si := sd.SignerInfos[0]
+
+	signerIdx := -1
+	for i, cert := range certs {
+		if signerInfoMatches(si, cert) {
+			signerIdx = i
+			break
+		}
+	}
+	if signerIdx < 0 {
+		return nil, nil, fmt.Errorf("%w: signer certificate not included", ErrInvalidTimestampToken)
+	}
+
+	certs[0], certs[signerIdx] = certs[signerIdx], certs[0]
+
+	err = checkSignerInfo(si, certs[0], sd.EncapContentInfo.EContent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &info, certs, nil
+}
+
+func signerInfoMatches(si signerInfo, cert *x509.Certificate) bool {
+	if si.SID.Class == asn1.ClassContextSpecific && si.SID.Tag == 0 {
+		return len(cert.SubjectKeyId) > 0 && bytes.Equal(si.SID.Bytes, cert.SubjectKeyId)
+	}
+
+	var ias issuerAndSerialNumber
+
+	_, err := asn1.Unmarshal(si.SID.FullBytes, &ias)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(ias.Issuer.FullBytes, cert.RawIssuer) && ias.SerialNumber.Cmp(cert.SerialNumber) == 0
+}
+
+func checkSignerInfo(si signerInfo, cert *x509.Certificate, content []byte) error {
+	hash, err := hashFor(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	sigAlg, err := signatureAlgorithmFor(si.DigestAlgorithm.Algorithm, si.SignatureAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	h := hash.New()
+	h.Write(content)
+	contentDigest := h.Sum(nil)
+
+	if len(si.SignedAttrs.FullBytes) == 0 {
+		// without signed attributes the signature is computed over the content itself
+		err = cert.CheckSignature(sigAlg, content, si.Signature)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrTimestampCannotBeVerified, err)
+		}
+		return nil
+	}
+
+	// signed attributes are signed with their universal SET OF tag instead of the implicit one
+	signedAttrs := append([]byte{}, si.SignedAttrs.FullBytes...)
+	signedAttrs[0] = 0x31
+
+	var attrs []attribute
+
+	_, err = asn1.UnmarshalWithParams(signedAttrs, &attrs, "set")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+	}
+
+	var digestChecked, contentTypeChecked bool
+
+	for _, attr := range attrs {
+		if len(attr.Values) != 1 {
+			continue
+		}
+
+		switch {
+		case attr.Type.Equal(oidMessageDigest):
+			var digest []byte
+
+			_, err = asn1.Unmarshal(attr.Values[0].FullBytes, &digest)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+			}
+			if !bytes.Equal(digest, contentDigest) {
+				return fmt.Errorf("%w: content digest mismatch", ErrTimestampCannotBeVerified)
+			}
+
+			digestChecked = true
+		case attr.Type.Equal(oidContentType):
+			var contentType asn1.ObjectIdentifier
+
+			_, err = asn1.Unmarshal(attr.Values[0].FullBytes, &contentType)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidTimestampToken, err)
+			}
+			if !contentType.Equal(oidTSTInfo) {
+				return fmt.Errorf("%w: content type mismatch", ErrInvalidTimestampToken)
+			}
+
+			contentTypeChecked = true
+		}
+	}
+
+	if !digestChecked || !contentTypeChecked {
+		return fmt.Errorf("%w: missing signed attributes", ErrInvalidTimestampToken)
+	}
+
+	err = cert.CheckSignature(sigAlg, signedAttrs, si.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTimestampCannotBeVerified, err)
+	}
+
+	return nil
+}
+
+func checkMessageImprint(info *tstInfo, payload []byte) error {
+	hash, err := hashFor(info.MessageImprint.HashAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	h := hash.New()
+	h.Write(payload)
+
+	if !bytes.Equal(h.Sum(nil), info.MessageImprint.HashedMessage) {
+		return fmt.Errorf("%w: the token was not issued over the payload", ErrTimestampCannotBeVerified)
+	}
+
+	return nil
+}
+
+func hashFor(digestAlg asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case digestAlg.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case digestAlg.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case digestAlg.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("%w: unsupported digest algorithm %v", ErrInvalidTimestampToken, digestAlg)
+}
+
+func signatureAlgorithmFor(digestAlg, sigAlg asn1.ObjectIdentifier) (x509.SignatureAlgorithm, error) {
+	hash, err := hashFor(digestAlg)
+	if err != nil {
+		return x509.UnknownSignatureAlgorithm, err
+	}
+
+	switch {
+	case sigAlg.Equal(oidSHA256WithRSA):
+		return x509.SHA256WithRSA, nil
+	case sigAlg.Equal(oidSHA384WithRSA):
+		return x509.SHA384WithRSA, nil
+	case sigAlg.Equal(oidSHA512WithRSA):
+		return x509.SHA512WithRSA, nil
+	case sigAlg.Equal(oidECDSAWithSHA256):
+		return x509.ECDSAWithSHA256, nil
+	case sigAlg.Equal(oidECDSAWithSHA384):
+		return x509.ECDSAWithSHA384, nil
+	case sigAlg.Equal(oidECDSAWithSHA512):
+		return x509.ECDSAWithSHA512, nil
+	case sigAlg.Equal(oidRSAEncryption):
+		switch hash {
+		case crypto.SHA256:
+			return x509.SHA256WithRSA, nil
+		case crypto.SHA384:
+			return x509.SHA384WithRSA, nil
+		case crypto.SHA512:
+			return x509.SHA512WithRSA, nil
+		}
+	case sigAlg.Equal(oidECPublicKey):
+		switch hash {
+		case crypto.SHA256:
+			return x509.ECDSAWithSHA256, nil
+		case crypto.SHA384:
+			return x509.ECDSAWithSHA384, nil
+		case crypto.SHA512:
+			return x509.ECDSAWithSHA512, nil
+		}
+	}
+
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: unsupported signature algorithm %v", ErrInvalidTimestampToken, sigAlg)
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTSA struct {
+	t      *testing.T
+	roots  *x509.CertPool
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	status int
+	// tamper is applied to the timestamp information before it's signed
+	tamper func(info *tstInfo)
+}
+
+func newFakeTSA(t *testing.T) *fakeTSA {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	tsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tsaTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test tsa"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}
+
+	tsaDER, err := x509.CreateCertificate(rand.Reader, tsaTemplate, caCert, &tsaKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	tsaCert, err := x509.ParseCertificate(tsaDER)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+
+	return &fakeTSA{t: t, roots: roots, cert: tsaCert, key: tsaKey}
+}
+
+func (tsa *fakeTSA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	require.NoError(tsa.t, err)
+
+	var req timeStampReq
+
+	_, err = asn1.Unmarshal(body, &req)
+	require.NoError(tsa.t, err)
+	require.True(tsa.t, req.CertReq)
+
+	resp := timeStampResp{Status: pkiStatusInfo{Status: tsa.status}}
+
+	if tsa.status == 0 {
+		resp.TimeStampToken = asn1.RawValue{FullBytes: tsa.issueToken(req)}
+	} else {
+		resp.Status.StatusString = []string{"rejected"}
+	}
+
+	bs, err := asn1.Marshal(resp)
+	require.NoError(tsa.t, err)
+
+	w.Header().Set("Content-Type", "application/timestamp-reply")
+	w.Write(bs)
+}
+
+func (tsa *fakeTSA) issueToken(req timeStampReq) []byte {
+	info := tstInfo{
+		Version:        1,
+		Policy:         asn1.ObjectIdentifier{1, 2, 3, 4},
+		MessageImprint: req.MessageImprint,
+		SerialNumber:   big.NewInt(time.Now().UnixNano()),
+		GenTime:        time.Now().UTC().Truncate(time.Second),
+		Nonce:          req.Nonce,
+	}
+
+	if tsa.tamper != nil {
+		tsa.tamper(&info)
+	}
+
+	content, err := asn1.Marshal(info)
+	require.NoError(tsa.t, err)
+
+	contentDigest := sha256.Sum256(content)
+
+	contentTypeValue, err := asn1.Marshal(oidTSTInfo)
+	require.NoError(tsa.t, err)
+
+	messageDigestValue, err := asn1.Marshal(contentDigest[:])
+	require.NoError(tsa.t, err)
+
+	signedAttrs, err := asn1.MarshalWithParams([]attribute{
+		{Type: oidContentType, Values: []asn1.RawValue{{FullBytes: contentTypeValue}}},
+		{Type: oidMessageDigest, Values: []asn1.RawValue{{FullBytes: messageDigestValue}}},
+	}, "set")
+	require.NoError(tsa.t, err)
+
+	signedAttrsDigest := sha256.Sum256(signedAttrs)
+
+	signature, err := ecdsa.SignASN1(rand.Reader, tsa.key, signedAttrsDigest[:])
+	require.NoError(tsa.t, err)
+
+	sid, err := asn1.Marshal(issuerAndSerialNumber{
+		Issuer:       asn1.RawValue{FullBytes: tsa.cert.RawIssuer},
+		SerialNumber: tsa.cert.SerialNumber,
+	})
+	require.NoError(tsa.t, err)
+
+	// signed attributes are encoded with the implicit [0] tag
+	implicitSignedAttrs := append([]byte{}, signedAttrs...)
+	implicitSignedAttrs[0] = 0xA0
+
+	sd, err := asn1.Marshal(signedData{
+		Version:          3,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: oidSHA256}},
+		EncapContentInfo: encapsulatedContentInfo{EContentType: oidTSTInfo, EContent: content},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: tsa.cert.Raw},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			SID:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: oidSHA256},
+			SignedAttrs:        asn1.RawValue{FullBytes: implicitSignedAttrs},
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256},
+			Signature:          signature,
+		}},
+	})
+	require.NoError(tsa.t, err)
+
+	token, err := asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd},
+	})
+	require.NoError(tsa.t, err)
+
+	return token
+}
+
+func TestTimestamp(t *testing.T) {
+	tsa := newFakeTSA(t)
+
+	srv := httptest.NewServer(tsa)
+	defer srv.Close()
+
+	timestamper := NewTSAClient(srv.URL, nil)
+
+	payload := []byte("state")
+
+	token, err := timestamper.Timestamp(payload)
+	require.NoError(t, err)
+
+	ts, err := VerifyTimestamp(payload, token, tsa.roots)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), ts, time.Minute)
+
+	t.Run("token issued over another payload", func(t *testing.T) {
+		_, err := VerifyTimestamp([]byte("another state"), token, tsa.roots)
+		require.ErrorIs(t, err, ErrTimestampCannotBeVerified)
+	})
+
+	t.Run("untrusted timestamp authority", func(t *testing.T) {
+		_, err := VerifyTimestamp(payload, token, x509.NewCertPool())
+		require.ErrorIs(t, err, ErrTimestampCannotBeVerified)
+	})
+
+	t.Run("tampered token", func(t *testing.T) {
+		// the last byte belongs to the signature of the timestamp authority
+		tampered := append([]byte{}, token...)
+		tampered[len(tampered)-1] ^= 0xFF
+
+		_, err := VerifyTimestamp(payload, tampered, tsa.roots)
+		require.ErrorIs(t, err, ErrTimestampCannotBeVerified)
+
+		_, err = VerifyTimestamp(payload, []byte("invalid"), tsa.roots)
+		require.ErrorIs(t, err, ErrInvalidTimestampToken)
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		tsa.tamper = func(info *tstInfo) { info.Nonce = big.NewInt(1) }
+		defer func() { tsa.tamper = nil }()
+
+		_, err := timestamper.Timestamp(payload)
+		require.ErrorIs(t, err, ErrInvalidTimestampToken)
+	})
+
+	t.Run("imprint mismatch", func(t *testing.T) {
+		tsa.tamper = func(info *tstInfo) { info.MessageImprint.HashedMessage = make([]byte, sha256.Size) }
+		defer func() { tsa.tamper = nil }()
+
+		_, err := timestamper.Timestamp(payload)
+		require.ErrorIs(t, err, ErrTimestampCannotBeVerified)
+	})
+
+	t.Run("request rejected", func(t *testing.T) {
+		tsa.status = 2
+		defer func() { tsa.status = 0 }()
+
+		_, err := timestamper.Timestamp(payload)
+		require.ErrorIs(t, err, ErrTimestampRejected)
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		defer srv.Close()
+
+		_, err := NewTSAClient(srv.URL, nil).Timestamp(payload)
+		require.ErrorIs(t, err, ErrTimestampRejected)
+	})
+}
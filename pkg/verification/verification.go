@@ -36,6 +36,8 @@ var ErrIllegalArguments = store.ErrIllegalArguments
 
 const documentPrefix = 3 // database.DocumentPrefix
 
+// VerifyDocument checks the proof binds doc to the proven transaction and returns the resulting state.
+// When serverSigningPubKey is provided, the proof must carry a signature of the target state made with it.
 func VerifyDocument(ctx context.Context,
 	proof *protomodel.ProofDocumentResponse,
 	doc *structpb.Struct,
@@ -204,7 +206,7 @@ func VerifyDocument(ctx context.Context,
 	if serverSigningPubKey != nil {
 		err := state.CheckSignature(serverSigningPubKey)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w: state signature could not be verified: %v", store.ErrInvalidProof, err)
 		}
 	}
 